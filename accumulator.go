@@ -0,0 +1,73 @@
+package money
+
+import (
+	"math/big"
+)
+
+// Accumulator sums Money of one currency with big.Int cents, so aggregates like GMV never overflow int64.
+// The total is only checked against int64 when converted back to Money.
+type Accumulator struct {
+	currencyIso string
+	cents       *big.Int
+	options     []MoneyOption
+}
+
+// NewAccumulator creates an empty Accumulator, options are applied to the Money returned by Money
+func NewAccumulator(isoCode string, options ...MoneyOption) *Accumulator {
+	return &Accumulator{
+		currencyIso: isoCode,
+		cents:       big.NewInt(0),
+		options:     options,
+	}
+}
+
+// Add adds the cents of all ms to the total. Nothing is added if any of ms has another currency.
+func (a *Accumulator) Add(ms ...*Money) error {
+	sum, err := a.sum(ms)
+	if err != nil {
+		return err
+	}
+	a.cents.Add(a.cents, sum)
+	return nil
+}
+
+// Subtract subtracts the cents of all ms from the total. Nothing is subtracted if any of ms has another currency.
+func (a *Accumulator) Subtract(ms ...*Money) error {
+	sum, err := a.sum(ms)
+	if err != nil {
+		return err
+	}
+	a.cents.Sub(a.cents, sum)
+	return nil
+}
+
+func (a *Accumulator) sum(ms []*Money) (*big.Int, error) {
+	if err := checkNil(ms...); err != nil {
+		return nil, err
+	}
+	sum := big.NewInt(0)
+	for _, m := range ms {
+		if m.CurrencyIso != a.currencyIso {
+			return nil, &CurrencyMismatchError{Expected: a.currencyIso, Actual: m.CurrencyIso}
+		}
+		sum.Add(sum, big.NewInt(m.Cents))
+	}
+	return sum, nil
+}
+
+// Cents returns a copy of the total cents
+func (a *Accumulator) Cents() *big.Int {
+	return new(big.Int).Set(a.cents)
+}
+
+func (a *Accumulator) GetCurrencyIso() string {
+	return a.currencyIso
+}
+
+// Money returns the total as Money, or ErrOverflow if it does not fit in int64 cents
+func (a *Accumulator) Money() (*Money, error) {
+	if !a.cents.IsInt64() {
+		return nil, ErrOverflow
+	}
+	return New(a.cents.Int64(), a.currencyIso, a.options...), nil
+}
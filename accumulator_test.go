@@ -0,0 +1,36 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccumulator(t *testing.T) {
+	a := NewAccumulator("VND", WithRoundingMode(RoundUp))
+	assert.NoError(t, a.Add(New(math.MaxInt64, "VND"), New(math.MaxInt64, "VND")))
+	assert.NoError(t, a.Subtract(New(1, "VND")))
+
+	expected := new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(2))
+	expected.Sub(expected, big.NewInt(1))
+	assert.Equal(t, expected, a.Cents())
+
+	_, err := a.Money()
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	assert.NoError(t, a.Subtract(New(math.MaxInt64, "VND")))
+	m, err := a.Money()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64-1), m.Cents)
+	assert.Equal(t, RoundUp, m.GetRoundingMode())
+}
+
+func TestAccumulator_DifferentCurrencies(t *testing.T) {
+	a := NewAccumulator("VND")
+	err := a.Add(New(1, "VND"), New(1, "USD"))
+	assert.Error(t, err)
+	assert.Equal(t, "currencies don't match: VND and USD", err.Error())
+	assert.Equal(t, big.NewInt(0), a.Cents())
+}
@@ -0,0 +1,115 @@
+package money
+
+import (
+	"math/big"
+	"sort"
+)
+
+// Sum returns new Money struct with value representing the sum of ms. The sum is computed with big.Int, so it only
+// fails with ErrOverflow when the total itself does not fit in int64 cents.
+// The rounding mode and smallest denomination are aligned the way Add does.
+func Sum(ms ...*Money) (*Money, error) {
+	if err := validateAggregate(ms); err != nil {
+		return nil, err
+	}
+	sum := sumCents(ms)
+	if !sum.IsInt64() {
+		return nil, ErrOverflow
+	}
+	return aggregate(ms, sum.Int64()).fill(), nil
+}
+
+// Min returns new Money struct with value representing the smallest of ms.
+// The rounding mode and smallest denomination are aligned the way Add does.
+func Min(ms ...*Money) (*Money, error) {
+	if err := validateAggregate(ms); err != nil {
+		return nil, err
+	}
+	min := ms[0].Cents
+	for _, m := range ms[1:] {
+		if m.Cents < min {
+			min = m.Cents
+		}
+	}
+	return aggregate(ms, min).fill(), nil
+}
+
+// Max returns new Money struct with value representing the largest of ms.
+// The rounding mode and smallest denomination are aligned the way Add does.
+func Max(ms ...*Money) (*Money, error) {
+	if err := validateAggregate(ms); err != nil {
+		return nil, err
+	}
+	max := ms[0].Cents
+	for _, m := range ms[1:] {
+		if m.Cents > max {
+			max = m.Cents
+		}
+	}
+	return aggregate(ms, max).fill(), nil
+}
+
+// Average returns new Money struct with value representing the mean of ms.
+// The mean is computed exactly before the aligned rounding mode and smallest denomination are applied once.
+func Average(ms ...*Money) (*Money, error) {
+	if err := validateAggregate(ms); err != nil {
+		return nil, err
+	}
+	return aggregateRat(ms, new(big.Rat).SetFrac(sumCents(ms), big.NewInt(int64(len(ms)))))
+}
+
+// Median returns new Money struct with value representing the median of ms. For an even number of ms it is the mean
+// of the two middle values, computed exactly before the aligned rounding mode and smallest denomination are applied.
+func Median(ms ...*Money) (*Money, error) {
+	if err := validateAggregate(ms); err != nil {
+		return nil, err
+	}
+	cents := make([]int64, 0, len(ms))
+	for _, m := range ms {
+		cents = append(cents, m.Cents)
+	}
+	sort.Slice(cents, func(i, j int) bool { return cents[i] < cents[j] })
+
+	middle := len(cents) / 2
+	if len(cents)%2 == 1 {
+		return aggregate(ms, cents[middle]).fill(), nil
+	}
+	sum := new(big.Int).Add(big.NewInt(cents[middle-1]), big.NewInt(cents[middle]))
+	return aggregateRat(ms, new(big.Rat).SetFrac(sum, big.NewInt(2)))
+}
+
+func validateAggregate(ms []*Money) error {
+	if len(ms) == 0 {
+		return ErrNoMoney
+	}
+	if err := checkNil(ms...); err != nil {
+		return err
+	}
+	return checkCurrency(ms[0], ms[1:]...)
+}
+
+func sumCents(ms []*Money) *big.Int {
+	sum := big.NewInt(0)
+	for _, m := range ms {
+		sum.Add(sum, big.NewInt(m.Cents))
+	}
+	return sum
+}
+
+// aggregate creates the result of an aggregate over ms, with rounding mode and smallest denomination aligned like Add.
+// Label and Dollars are left empty.
+func aggregate(ms []*Money, cents int64) *Money {
+	nm := ms[0].withCents(cents)
+	nm.roundingMode = alignedRoundingMode(ms[0], ms[1:])
+	nm.smallestDenomination = alignedSmallestDenomination(ms[0], ms[1:])
+	return nm
+}
+
+func aggregateRat(ms []*Money, cents *big.Rat) (*Money, error) {
+	nm := aggregate(ms, 0)
+	rounded, err := nm.roundRat(cents)
+	if err != nil {
+		return nil, err
+	}
+	return nm.withCents(rounded).fill(), nil
+}
@@ -0,0 +1,116 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	gomoney "github.com/Rhymond/go-money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregate(t *testing.T) {
+	testTable := []struct {
+		cents           []int64
+		roundingMode    RoundingMode
+		expectedSum     int64
+		expectedMin     int64
+		expectedMax     int64
+		expectedAverage int64
+		expectedMedian  int64
+	}{
+		{
+			cents:           []int64{100},
+			expectedSum:     100,
+			expectedMin:     100,
+			expectedMax:     100,
+			expectedAverage: 100,
+			expectedMedian:  100,
+		},
+		{
+			cents:           []int64{300, -100, 200},
+			expectedSum:     400,
+			expectedMin:     -100,
+			expectedMax:     300,
+			expectedAverage: 133,
+			expectedMedian:  200,
+		},
+		{
+			cents:           []int64{100, 101, 102, 103},
+			roundingMode:    RoundBankers,
+			expectedSum:     406,
+			expectedMin:     100,
+			expectedMax:     103,
+			expectedAverage: 102,
+			expectedMedian:  102,
+		},
+		{
+			cents:           []int64{100, 101, 102, 103},
+			roundingMode:    RoundDown,
+			expectedSum:     406,
+			expectedMin:     100,
+			expectedMax:     103,
+			expectedAverage: 101,
+			expectedMedian:  101,
+		},
+		{
+			cents:           []int64{math.MaxInt64, math.MaxInt64, math.MinInt64},
+			expectedSum:     math.MaxInt64 - 1,
+			expectedMin:     math.MinInt64,
+			expectedMax:     math.MaxInt64,
+			expectedAverage: math.MaxInt64 / 3,
+			expectedMedian:  math.MaxInt64,
+		},
+	}
+	for _, item := range testTable {
+		ms := make([]*Money, 0, len(item.cents))
+		for _, cents := range item.cents {
+			ms = append(ms, New(cents, "USD", WithRoundingMode(item.roundingMode)))
+		}
+		for _, aggregate := range []struct {
+			fn       func(ms ...*Money) (*Money, error)
+			expected int64
+		}{
+			{fn: Sum, expected: item.expectedSum},
+			{fn: Min, expected: item.expectedMin},
+			{fn: Max, expected: item.expectedMax},
+			{fn: Average, expected: item.expectedAverage},
+			{fn: Median, expected: item.expectedMedian},
+		} {
+			m, err := aggregate.fn(ms...)
+			assert.NoError(t, err)
+			assert.Equal(t, aggregate.expected, m.Cents, item.cents)
+			assert.Equal(t, "USD", m.CurrencyIso)
+		}
+	}
+}
+
+func TestAggregate_Alignment(t *testing.T) {
+	ms := []*Money{
+		New(1000, "TWD", WithRoundingMode(RoundUp), WithSmallestDenomination(100)),
+		New(1050, "TWD"),
+	}
+	average, err := Average(ms...)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1100), average.Cents)
+	assert.Equal(t, RoundUp, average.GetRoundingMode())
+	assert.Equal(t, int32(100), average.GetSmallestDenomination())
+
+	min, err := Min(ms...)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), min.Cents)
+	assert.Equal(t, RoundUp, min.GetRoundingMode())
+	assert.Equal(t, int32(100), min.GetSmallestDenomination())
+}
+
+func TestAggregate_WithError(t *testing.T) {
+	for _, fn := range []func(ms ...*Money) (*Money, error){Sum, Min, Max, Average, Median} {
+		_, err := fn()
+		assert.ErrorIs(t, err, ErrNoMoney)
+
+		_, err = fn(New(100, "USD"), New(100, "TWD"))
+		assert.ErrorIs(t, err, gomoney.ErrCurrencyMismatch)
+	}
+
+	_, err := Sum(New(math.MaxInt64, "USD"), New(1, "USD"))
+	assert.ErrorIs(t, err, ErrOverflow)
+}
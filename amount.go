@@ -0,0 +1,273 @@
+package money
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// Amount is an immutable value-type counterpart of Money. Its fields are unexported so they cannot drift apart,
+// and the With methods return modified copies instead of mutating shared instances.
+//
+// The zero value is an absent amount, the counterpart of a nil *Money: ToMoney returns nil for it and its operations
+// return ErrNilMoney. Use ToAmount and ToMoney to migrate between Money and Amount incrementally.
+type Amount struct {
+	cents                int64
+	currencyIso          string
+	roundingMode         RoundingMode
+	symmetricRounding    bool
+	smallestDenomination int32
+	currency             *Currency
+	registry             *Registry
+}
+
+// NewAmount creates Amount the way New creates Money
+func NewAmount(cents int64, isoCode string, options ...MoneyOption) Amount {
+	return New(cents, isoCode, options...).ToAmount()
+}
+
+// ToAmount returns the Amount of m with the same currency, registry and rounding settings, a nil Money is absent
+func (m *Money) ToAmount() Amount {
+	if m == nil {
+		return Amount{}
+	}
+	return Amount{
+		cents:                m.Cents,
+		currencyIso:          m.CurrencyIso,
+		roundingMode:         m.roundingMode,
+		symmetricRounding:    m.symmetricRounding,
+		smallestDenomination: m.smallestDenomination,
+		currency:             m.GetCurrency(),
+		registry:             m.registry,
+	}
+}
+
+// ToMoney returns new Money struct of a with the same currency, registry and rounding settings, nil if a is absent.
+// The currency is the one a was created with, even if the registry has changed since.
+func (a Amount) ToMoney() *Money {
+	if a.IsAbsent() {
+		return nil
+	}
+	return a.money().fill()
+}
+
+// money is ToMoney leaving Label and Dollars empty, for the Money operations behind the methods of Amount
+func (a Amount) money() *Money {
+	if a.IsAbsent() {
+		return nil
+	}
+	return &Money{
+		Cents:                a.cents,
+		CurrencyIso:          a.currencyIso,
+		CurrencySymbol:       a.currency.Grapheme,
+		roundingMode:         a.roundingMode,
+		symmetricRounding:    a.symmetricRounding,
+		smallestDenomination: a.smallestDenomination,
+		currency:             a.currency,
+		registry:             a.registry,
+	}
+}
+
+// IsAbsent returns true for the zero value of Amount
+func (a Amount) IsAbsent() bool {
+	return a.currencyIso == ""
+}
+
+func (a Amount) Cents() int64 {
+	return a.cents
+}
+
+func (a Amount) CurrencyIso() string {
+	return a.currencyIso
+}
+
+func (a Amount) Currency() *Currency {
+	return a.currency
+}
+
+func (a Amount) RoundingMode() RoundingMode {
+	return a.roundingMode
+}
+
+func (a Amount) IsSymmetricRounding() bool {
+	return a.symmetricRounding
+}
+
+func (a Amount) SmallestDenomination() int32 {
+	return a.smallestDenomination
+}
+
+// Label returns the amount formatted with its currency, e.g. "US$28.55", or an empty string if a is absent
+func (a Amount) Label() string {
+	if a.IsAbsent() {
+		return ""
+	}
+	return a.currency.Formatter().Format(a.cents)
+}
+
+// Dollars returns the amount in major units, e.g. 28.55 for 2855 cents of USD
+func (a Amount) Dollars() float64 {
+	if a.IsAbsent() {
+		return 0
+	}
+	return a.currency.Formatter().ToMajorUnits(a.cents)
+}
+
+func (a Amount) String() string {
+	return a.Label()
+}
+
+// WithRoundingMode returns a copy of a with the rounding mode changed
+func (a Amount) WithRoundingMode(mode RoundingMode) Amount {
+	a.roundingMode = mode
+	return a
+}
+
+// WithSymmetricRounding returns a copy of a with symmetric rounding changed, see the MoneyOption WithSymmetricRounding
+func (a Amount) WithSymmetricRounding(symmetric bool) Amount {
+	a.symmetricRounding = symmetric
+	return a
+}
+
+// WithSmallestDenomination returns a copy of a with the smallest denomination changed
+func (a Amount) WithSmallestDenomination(smallestDenomination int32) Amount {
+	a.smallestDenomination = smallestDenomination
+	return a
+}
+
+func (a Amount) IsZero() bool {
+	return !a.IsAbsent() && a.cents == 0
+}
+
+func (a Amount) IsPositive() bool {
+	return a.cents > 0
+}
+
+func (a Amount) IsNegative() bool {
+	return a.cents < 0
+}
+
+// Compare compares a and b like the package function Compare
+func (a Amount) Compare(b Amount) (int, error) {
+	return Compare(a.money(), b.money())
+}
+
+// Equals checks equality of the amounts and currencies of a and b, the rounding settings are not compared
+func (a Amount) Equals(b Amount) (bool, error) {
+	c, err := a.Compare(b)
+	return c == 0, err
+}
+
+// Add returns the sum of a and others, see Money.Add
+func (a Amount) Add(others ...Amount) (Amount, error) {
+	return a.apply(func(m *Money) (*Money, error) {
+		return m.add(toMonies(others)...)
+	})
+}
+
+// Subtract returns the difference of a and others, see Money.Subtract
+func (a Amount) Subtract(others ...Amount) (Amount, error) {
+	return a.apply(func(m *Money) (*Money, error) {
+		return m.subtract(toMonies(others)...)
+	})
+}
+
+// Negative returns a with the sign flipped, or ErrOverflow when the cents are math.MinInt64
+func (a Amount) Negative() (Amount, error) {
+	if a.IsAbsent() {
+		return Amount{}, ErrNilMoney
+	}
+	if a.cents == math.MinInt64 {
+		return Amount{}, ErrOverflow
+	}
+	a.cents = -a.cents
+	return a, nil
+}
+
+// MultiplyRat returns a multiplied by mul, see Money.MultiplyRat
+func (a Amount) MultiplyRat(mul *big.Rat) (Amount, error) {
+	return a.apply(func(m *Money) (*Money, error) {
+		return m.multiplyRat(mul)
+	})
+}
+
+// DivideRat returns a divided by div, see Money.DivideRat
+func (a Amount) DivideRat(div *big.Rat) (Amount, error) {
+	return a.apply(func(m *Money) (*Money, error) {
+		return m.divideRat(div)
+	})
+}
+
+// Percent returns p of a, see Money.Percent
+func (a Amount) Percent(p Percentage) (Amount, error) {
+	return a.apply(func(m *Money) (*Money, error) {
+		return m.multiplyRat(p.Rat())
+	})
+}
+
+// apply runs the Money operation fn on a
+func (a Amount) apply(fn func(m *Money) (*Money, error)) (Amount, error) {
+	m, err := fn(a.money())
+	if err != nil {
+		return Amount{}, err
+	}
+	return m.ToAmount(), nil
+}
+
+func toMonies(amounts []Amount) []*Money {
+	ms := make([]*Money, len(amounts))
+	for i, amount := range amounts {
+		ms[i] = amount.money()
+	}
+	return ms
+}
+
+// MarshalJSON implements json.Marshaler with the same payload as Money, an absent Amount is null
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.ToMoney())
+}
+
+// UnmarshalJSON implements json.Unmarshaler with the same payload and validation as Money, null is absent
+func (a *Amount) UnmarshalJSON(b []byte) error {
+	var m *Money
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	*a = m.ToAmount()
+	return nil
+}
+
+// MarshalBSONValue implements bson.ValueMarshaler with the same document as Money, an absent Amount is null.
+// A value is not a document, so marshal ToMoney instead to store an Amount as a document of its own.
+func (a Amount) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	m := a.ToMoney()
+	if m == nil {
+		return bsontype.Null, nil, nil
+	}
+	b, err := bson.Marshal(m)
+	if err != nil {
+		return 0, nil, err
+	}
+	return bsontype.EmbeddedDocument, b, nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler with the same document and validation as Money, null is absent
+func (a *Amount) UnmarshalBSONValue(t bsontype.Type, b []byte) error {
+	switch t {
+	case bsontype.Null:
+		*a = Amount{}
+		return nil
+	case bsontype.EmbeddedDocument:
+		var m Money
+		if err := m.UnmarshalBSON(b); err != nil {
+			return err
+		}
+		*a = m.ToAmount()
+		return nil
+	default:
+		return ErrInvalidPayload
+	}
+}
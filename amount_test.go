@@ -0,0 +1,172 @@
+package money
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+func TestAmount(t *testing.T) {
+	a := NewAmount(2855, "USD", WithRoundingMode(RoundUp))
+	assert.Equal(t, int64(2855), a.Cents())
+	assert.Equal(t, "USD", a.CurrencyIso())
+	assert.Equal(t, "US$", a.Currency().Grapheme)
+	assert.Equal(t, "US$28.55", a.Label())
+	assert.Equal(t, "US$28.55", a.String())
+	assert.Equal(t, 28.55, a.Dollars())
+	assert.Equal(t, RoundUp, a.RoundingMode())
+	assert.Equal(t, int32(1), a.SmallestDenomination())
+	assert.False(t, a.IsSymmetricRounding())
+	assert.True(t, a.IsPositive())
+	assert.False(t, a.IsAbsent())
+
+	// With methods return copies
+	b := a.WithRoundingMode(RoundDown).WithSmallestDenomination(5).WithSymmetricRounding(true)
+	assert.Equal(t, RoundUp, a.RoundingMode())
+	assert.Equal(t, RoundDown, b.RoundingMode())
+	assert.Equal(t, int32(5), b.SmallestDenomination())
+	assert.True(t, b.IsSymmetricRounding())
+
+	sum, err := a.Add(NewAmount(45, "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2900), sum.Cents())
+	assert.Equal(t, int64(2855), a.Cents())
+
+	diff, err := a.Subtract(NewAmount(3000, "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-145), diff.Cents())
+	assert.True(t, diff.IsNegative())
+
+	neg, err := a.Negative()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-2855), neg.Cents())
+
+	product, err := a.MultiplyRat(big.NewRat(1, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(952), product.Cents())
+
+	quotient, err := b.DivideRat(big.NewRat(3, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(950), quotient.Cents())
+
+	tax, err := a.Percent(MustPercentage("5"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(143), tax.Cents())
+
+	c, err := a.Compare(sum)
+	assert.NoError(t, err)
+	assert.Equal(t, -1, c)
+	equal, err := a.Equals(b)
+	assert.NoError(t, err)
+	assert.True(t, equal)
+
+	_, err = a.Add(NewAmount(100, "TWD"))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = NewAmount(math.MinInt64, "USD").Negative()
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestAmount_Money(t *testing.T) {
+	r := NewRegistry()
+	assert.NoError(t, r.Register(NewCurrency("PTS", 0, WithGrapheme(" pts"))))
+	m := New(1500, "PTS", WithRegistry(r), WithRoundingMode(RoundHalfUp), WithSymmetricRounding(true), WithSmallestDenomination(10))
+
+	a := m.ToAmount()
+	assert.Equal(t, "1,500 pts", a.Label())
+
+	nm := a.ToMoney()
+	assert.Equal(t, m.Cents, nm.Cents)
+	assert.Equal(t, m.Label, nm.GetLabel())
+	assert.Equal(t, RoundHalfUp, nm.GetRoundingMode())
+	assert.True(t, nm.IsSymmetricRounding())
+	assert.Equal(t, int32(10), nm.GetSmallestDenomination())
+	assert.Equal(t, r, nm.registry)
+
+	// Mutating the Money does not change the Amount
+	nm.SetRoundingMode(RoundDown)
+	assert.Equal(t, RoundHalfUp, a.RoundingMode())
+}
+
+func TestAmount_CurrencyReplaced(t *testing.T) {
+	r := NewRegistry()
+	assert.NoError(t, r.Register(NewCurrency("PTS", 0, WithGrapheme(" pts"))))
+	a := NewAmount(100, "PTS", WithRegistry(r))
+
+	// Amounts keep the currency they were created with
+	assert.NoError(t, r.Register(NewCurrency("PTS", 2, WithGrapheme(" pts"))))
+	sum, err := a.Add(NewAmount(0, "PTS", WithRegistry(r)))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sum.Currency().Fraction)
+	assert.Equal(t, "100 pts", sum.Label())
+	assert.Equal(t, "100 pts", a.ToMoney().GetLabel())
+}
+
+func TestAmount_Absent(t *testing.T) {
+	var a Amount
+	var m *Money
+	assert.True(t, a.IsAbsent())
+	assert.True(t, m.ToAmount().IsAbsent())
+	assert.Nil(t, a.ToMoney())
+	assert.False(t, a.IsZero())
+	assert.Equal(t, "", a.Label())
+	assert.Equal(t, float64(0), a.Dollars())
+
+	_, err := a.Add(NewAmount(100, "USD"))
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = NewAmount(100, "USD").Add(a)
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = a.Negative()
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = a.Compare(NewAmount(100, "USD"))
+	assert.ErrorIs(t, err, ErrNilMoney)
+}
+
+type invoice struct {
+	Total    Amount `json:"total" bson:"total"`
+	Discount Amount `json:"discount" bson:"discount"`
+}
+
+func TestAmount_Marshal(t *testing.T) {
+	a := NewAmount(2855, "USD")
+	b, err := json.Marshal(invoice{Total: a})
+	assert.NoError(t, err)
+	mb, err := json.Marshal(New(2855, "USD"))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"total": `+string(mb)+`, "discount": null}`, string(b))
+
+	var i invoice
+	assert.NoError(t, json.Unmarshal(b, &i))
+	assert.Equal(t, a.Cents(), i.Total.Cents())
+	assert.Equal(t, "US$28.55", i.Total.Label())
+	assert.True(t, i.Discount.IsAbsent())
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"total": {"cents": 100}}`), &i), ErrInvalidPayload)
+
+	b, err = bson.Marshal(invoice{Total: a, Discount: NewAmount(-100, "USD")})
+	assert.NoError(t, err)
+	var bi invoice
+	assert.NoError(t, bson.Unmarshal(b, &bi))
+	assert.Equal(t, int64(2855), bi.Total.Cents())
+	assert.Equal(t, int64(-100), bi.Discount.Cents())
+	assert.Equal(t, "-US$1.00", bi.Discount.Label())
+
+	// An absent Amount is stored as null and read back as absent
+	b, err = bson.Marshal(invoice{Total: a})
+	assert.NoError(t, err)
+	raw, err := bson.Raw(b).LookupErr("discount")
+	assert.NoError(t, err)
+	assert.Equal(t, bsontype.Null, raw.Type)
+	bi = invoice{Discount: NewAmount(100, "USD")}
+	assert.NoError(t, bson.Unmarshal(b, &bi))
+	assert.Equal(t, int64(2855), bi.Total.Cents())
+	assert.True(t, bi.Discount.IsAbsent())
+
+	b, err = bson.Marshal(bson.M{"total": "USD 28.55"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, bson.Unmarshal(b, &bi), ErrInvalidPayload)
+}
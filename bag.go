@@ -0,0 +1,149 @@
+package money
+
+import (
+	"math"
+	"sort"
+)
+
+// Bag holds Money of several currencies, e.g. the totals of a multi-currency cart or wallet.
+// Amounts of the same currency are added together, amounts of different currencies are kept apart.
+// The zero value is an empty Bag ready to use. A nil *Bag reads as an empty Bag, Add and Subtract return ErrNilMoney.
+type Bag struct {
+	amounts map[string]*Money
+}
+
+// NewBag creates a Bag holding the sum of ms per currency
+func NewBag(ms ...*Money) (*Bag, error) {
+	b := &Bag{amounts: map[string]*Money{}}
+	if err := b.Add(ms...); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Add adds ms to the amounts of their currencies, see Money.Add for the rounding mode of the sums.
+// Nothing is added if any sum overflows.
+func (b *Bag) Add(ms ...*Money) error {
+	return b.update(ms, func(amount *Money, m *Money) (*Money, error) {
+		return amount.add(m)
+	})
+}
+
+// Subtract subtracts ms from the amounts of their currencies, see Money.Subtract for the rounding mode of the differences.
+// Nothing is subtracted if any difference overflows.
+func (b *Bag) Subtract(ms ...*Money) error {
+	return b.update(ms, func(amount *Money, m *Money) (*Money, error) {
+		return amount.subtract(m)
+	})
+}
+
+func (b *Bag) update(ms []*Money, fn func(amount *Money, m *Money) (*Money, error)) error {
+	if b == nil {
+		return ErrNilMoney
+	}
+	if err := checkNil(ms...); err != nil {
+		return err
+	}
+	updated := map[string]*Money{}
+	for _, m := range ms {
+		amount, ok := updated[m.CurrencyIso]
+		if !ok {
+			if amount, ok = b.amounts[m.CurrencyIso]; !ok {
+				amount = m.withCents(0)
+			}
+		}
+		amount, err := fn(amount, m)
+		if err != nil {
+			return err
+		}
+		updated[m.CurrencyIso] = amount
+	}
+	if b.amounts == nil {
+		b.amounts = make(map[string]*Money, len(updated))
+	}
+	for code, amount := range updated {
+		b.amounts[code] = amount.fill()
+	}
+	return nil
+}
+
+// Negate returns new Bag holding every amount of b with the sign flipped, e.g. to reverse a cart.
+// Unlike Money.Negative, negative amounts become positive. ErrOverflow is returned when any cents are math.MinInt64.
+func (b *Bag) Negate() (*Bag, error) {
+	amounts := b.all()
+	nb := &Bag{amounts: make(map[string]*Money, len(amounts))}
+	for code, amount := range amounts {
+		if amount.Cents == math.MinInt64 {
+			return nil, ErrOverflow
+		}
+		nb.amounts[code] = amount.withCents(-amount.Cents).fill()
+	}
+	return nb, nil
+}
+
+// Get returns the amount of currency isoCode, false if b never held the currency
+func (b *Bag) Get(isoCode string) (*Money, bool) {
+	amount, ok := b.all()[isoCode]
+	return amount, ok
+}
+
+// Currencies returns the ISO codes of the currencies held by b, sorted by code
+func (b *Bag) Currencies() []string {
+	amounts := b.all()
+	codes := make([]string, 0, len(amounts))
+	for code := range amounts {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// List returns the amounts held by b, sorted by currency code
+func (b *Bag) List() []*Money {
+	held := b.all()
+	codes := b.Currencies()
+	amounts := make([]*Money, 0, len(codes))
+	for _, code := range codes {
+		amounts = append(amounts, held[code])
+	}
+	return amounts
+}
+
+// IsZero returns true if every amount held by b is zero
+func (b *Bag) IsZero() bool {
+	for _, amount := range b.all() {
+		if !amount.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// Convert returns the total of b in the target currency. Every amount is converted with converter, see Converter.Convert,
+// before the converted amounts are added. An empty Bag converts to zero, a nil converter returns ErrNilMoney.
+func (b *Bag) Convert(converter *Converter, targetIso string) (*Money, error) {
+	if converter == nil {
+		return nil, ErrNilMoney
+	}
+	amounts := b.List()
+	if len(amounts) == 0 {
+		return New(0, targetIso, converter.options...), nil
+	}
+	converted := make([]*Money, 0, len(amounts))
+	for _, amount := range amounts {
+		m, err := converter.Convert(amount, targetIso)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, m)
+	}
+	return converted[0].Add(converted[1:]...)
+}
+
+// all returns the amounts held by b, nil for a nil Bag
+func (b *Bag) all() map[string]*Money {
+	if b == nil {
+		return nil
+	}
+	return b.amounts
+}
@@ -0,0 +1,143 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBag(t *testing.T) {
+	b, err := NewBag(New(1000, "USD"), New(300, "TWD"), New(250, "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TWD", "USD"}, b.Currencies())
+
+	usd, ok := b.Get("USD")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1250), usd.Cents)
+	_, ok = b.Get("JPY")
+	assert.False(t, ok)
+
+	assert.NoError(t, b.Add(New(500, "JPY"), New(50, "USD")))
+	assert.NoError(t, b.Subtract(New(300, "TWD"), New(800, "USD")))
+	assert.Equal(t, []int64{500, 0, 500}, lo.Map(b.List(), func(m *Money, _ int) int64 { return m.Cents }))
+	assert.False(t, b.IsZero())
+
+	nb, err := b.Negate()
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{-500, 0, -500}, lo.Map(nb.List(), func(m *Money, _ int) int64 { return m.Cents }))
+	// Negate leaves b untouched
+	usd, _ = b.Get("USD")
+	assert.Equal(t, int64(500), usd.Cents)
+
+	assert.NoError(t, b.Add(nb.List()...))
+	assert.True(t, b.IsZero())
+
+	// Negative amounts become positive
+	b, err = NewBag(New(-300, "USD"), New(200, "TWD"))
+	assert.NoError(t, err)
+	nb, err = b.Negate()
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{-200, 300}, lo.Map(nb.List(), func(m *Money, _ int) int64 { return m.Cents }))
+
+	// Subtract can reach math.MinInt64, which has no positive counterpart
+	b, err = NewBag(New(math.MinInt64+1, "USD"))
+	assert.NoError(t, err)
+	assert.NoError(t, b.Subtract(New(1, "USD")))
+	_, err = b.Negate()
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestBag_ZeroValue(t *testing.T) {
+	var b Bag
+	assert.True(t, b.IsZero())
+	assert.Empty(t, b.List())
+
+	assert.NoError(t, b.Add(New(100, "USD")))
+	assert.NoError(t, b.Subtract(New(30, "TWD")))
+	assert.Equal(t, []int64{-30, 100}, lo.Map(b.List(), func(m *Money, _ int) int64 { return m.Cents }))
+}
+
+func TestBag_Nil(t *testing.T) {
+	var b *Bag
+	assert.True(t, b.IsZero())
+	assert.Empty(t, b.List())
+	assert.Empty(t, b.Currencies())
+	_, ok := b.Get("USD")
+	assert.False(t, ok)
+
+	nb, err := b.Negate()
+	assert.NoError(t, err)
+	assert.Empty(t, nb.List())
+
+	total, err := b.Convert(NewConverter(StaticRates{}), "TWD")
+	assert.NoError(t, err)
+	assert.True(t, total.IsZero())
+	assert.Equal(t, "TWD", total.CurrencyIso)
+
+	assert.ErrorIs(t, b.Add(New(100, "USD")), ErrNilMoney)
+	assert.ErrorIs(t, b.Subtract(New(100, "USD")), ErrNilMoney)
+
+	_, err = b.Convert(nil, "TWD")
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = new(Bag).Convert(nil, "TWD")
+	assert.ErrorIs(t, err, ErrNilMoney)
+	var c *Converter
+	_, err = c.Convert(New(100, "USD"), "TWD")
+	assert.ErrorIs(t, err, ErrNilMoney)
+}
+
+func TestBag_RoundingMode(t *testing.T) {
+	b, err := NewBag(New(1000, "USD", WithRoundingMode(RoundUp), WithSmallestDenomination(5)), New(100, "USD"))
+	assert.NoError(t, err)
+	usd, _ := b.Get("USD")
+	assert.Equal(t, RoundUp, usd.GetRoundingMode())
+	assert.Equal(t, int32(5), usd.GetSmallestDenomination())
+}
+
+func TestBag_Overflow(t *testing.T) {
+	b, err := NewBag(New(math.MaxInt64, "USD"))
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, b.Add(New(100, "TWD"), New(1, "USD")), ErrOverflow)
+	// Nothing is added when any sum overflows
+	_, ok := b.Get("TWD")
+	assert.False(t, ok)
+	usd, _ := b.Get("USD")
+	assert.Equal(t, int64(math.MaxInt64), usd.Cents)
+
+	_, err = NewBag(New(math.MinInt64, "USD"), New(-1, "USD"))
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestBag_Convert(t *testing.T) {
+	converter := NewConverter(StaticRates{
+		"USD": {
+			"TWD": big.NewRat(3215, 100),
+		},
+		"HKD": {
+			"TWD": big.NewRat(41, 10),
+		},
+	})
+	b, err := NewBag(New(1000, "USD"), New(10000, "HKD"), New(99, "TWD"))
+	assert.NoError(t, err)
+
+	total, err := b.Convert(converter, "TWD")
+	assert.NoError(t, err)
+	// 322 from USD, 410 from HKD and 99 in TWD
+	assert.Equal(t, int64(831), total.Cents)
+	assert.Equal(t, "TWD", total.CurrencyIso)
+
+	assert.NoError(t, b.Add(New(100, "JPY")))
+	_, err = b.Convert(converter, "TWD")
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	empty, err := NewBag()
+	assert.NoError(t, err)
+	total, err = empty.Convert(converter, "TWD")
+	assert.NoError(t, err)
+	assert.True(t, total.IsZero())
+	assert.Equal(t, "TWD", total.CurrencyIso)
+}
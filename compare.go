@@ -0,0 +1,65 @@
+package money
+
+import (
+	"sort"
+)
+
+// Compare returns -1 if a is less than b, 0 if they are equal and +1 if a is greater than b.
+// Money of different currencies are not comparable and return ErrCurrencyMismatch, nil Money return ErrNilMoney.
+func Compare(a *Money, b *Money) (int, error) {
+	if err := checkNil(a, b); err != nil {
+		return 0, err
+	}
+	if err := checkCurrency(a, b); err != nil {
+		return 0, err
+	}
+	switch {
+	case a.Cents < b.Cents:
+		return -1, nil
+	case a.Cents > b.Cents:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// CompareFunc is Compare for sorting functions like slices.SortFunc.
+// It panics with the error of Compare when a and b are not comparable, use Sort to get the error returned instead.
+func CompareFunc(a *Money, b *Money) int {
+	c, err := Compare(a, b)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Slice attaches the methods of sort.Interface to []*Money, sorting in increasing order.
+// Less panics with the error of Compare when the Money are not comparable, use Sort to get the error returned instead.
+type Slice []*Money
+
+func (s Slice) Len() int {
+	return len(s)
+}
+
+func (s Slice) Less(i, j int) bool {
+	return CompareFunc(s[i], s[j]) < 0
+}
+
+func (s Slice) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Sort sorts ms in increasing order, keeping the original order of equal Money.
+// ms is left untouched and ErrNilMoney or ErrCurrencyMismatch is returned when ms are not comparable.
+func Sort(ms []*Money) error {
+	if err := checkNil(ms...); err != nil {
+		return err
+	}
+	if len(ms) > 0 {
+		if err := checkCurrency(ms[0], ms[1:]...); err != nil {
+			return err
+		}
+	}
+	sort.Stable(Slice(ms))
+	return nil
+}
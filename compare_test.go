@@ -0,0 +1,71 @@
+package money
+
+import (
+	"sort"
+	"testing"
+
+	gomoney "github.com/Rhymond/go-money"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	testTable := []struct {
+		a        *Money
+		b        *Money
+		expected int
+	}{
+		{
+			a:        New(100, "USD"),
+			b:        New(200, "USD"),
+			expected: -1,
+		},
+		{
+			a:        New(200, "USD"),
+			b:        New(200, "USD", WithRoundingMode(RoundUp)),
+			expected: 0,
+		},
+		{
+			a:        New(200, "USD"),
+			b:        New(-300, "USD"),
+			expected: 1,
+		},
+	}
+	for _, item := range testTable {
+		c, err := Compare(item.a, item.b)
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, c)
+		assert.Equal(t, item.expected, CompareFunc(item.a, item.b))
+	}
+
+	_, err := Compare(New(100, "USD"), New(100, "TWD"))
+	assert.ErrorIs(t, err, gomoney.ErrCurrencyMismatch)
+	assert.PanicsWithError(t, "currencies don't match: USD and TWD", func() { CompareFunc(New(100, "USD"), New(100, "TWD")) })
+}
+
+func TestSort(t *testing.T) {
+	cents := func(ms []*Money) []int64 {
+		return lo.Map(ms, func(m *Money, _ int) int64 { return m.Cents })
+	}
+
+	ms := []*Money{New(300, "USD"), New(-100, "USD"), New(200, "USD", WithRoundingMode(RoundUp)), New(200, "USD")}
+	assert.NoError(t, Sort(ms))
+	assert.Equal(t, []int64{-100, 200, 200, 300}, cents(ms))
+	// Equal Money keep their order
+	assert.Equal(t, RoundUp, ms[1].GetRoundingMode())
+
+	ms = []*Money{New(300, "USD"), New(-100, "USD")}
+	sort.Sort(Slice(ms))
+	assert.Equal(t, []int64{-100, 300}, cents(ms))
+
+	ms = []*Money{New(300, "USD"), New(-100, "USD")}
+	sort.Slice(ms, func(i, j int) bool { return CompareFunc(ms[i], ms[j]) < 0 })
+	assert.Equal(t, []int64{-100, 300}, cents(ms))
+
+	ms = []*Money{New(300, "USD"), New(-100, "TWD")}
+	assert.ErrorIs(t, Sort(ms), gomoney.ErrCurrencyMismatch)
+	assert.Equal(t, []int64{300, -100}, cents(ms))
+	assert.Panics(t, func() { sort.Sort(Slice(ms)) })
+
+	assert.NoError(t, Sort(nil))
+}
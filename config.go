@@ -0,0 +1,127 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// currencyDefinition overrides the registered definition of a currency, nil fields are left unchanged
+type currencyDefinition struct {
+	Grapheme             *string `json:"grapheme" yaml:"grapheme"`
+	Template             *string `json:"template" yaml:"template"`
+	Decimal              *string `json:"decimal" yaml:"decimal"`
+	Thousand             *string `json:"thousand" yaml:"thousand"`
+	Fraction             *int    `json:"fraction" yaml:"fraction"`
+	SmallestDenomination *int32  `json:"smallest_denomination" yaml:"smallest_denomination"`
+	CashDenomination     *int32  `json:"cash_denomination" yaml:"cash_denomination"`
+}
+
+// LoadCurrencies loads currency definitions into the default registry, see Registry.LoadCurrencies
+func LoadCurrencies(r io.Reader, format Format) error {
+	return defaultRegistry.LoadCurrencies(r, format)
+}
+
+// LoadCurrencies loads currency definitions keyed by ISO code, e.g. in YAML
+//
+//	THB:
+//	  template: "$1"
+//	TWD:
+//	  fraction: 2
+//	  smallest_denomination: 100
+//	CHF:
+//	  cash_denomination: 5
+//
+// Omitted fields keep the currently registered value. Nothing is registered if any definition is invalid.
+func (r *Registry) LoadCurrencies(reader io.Reader, format Format) error {
+	definitions := map[string]currencyDefinition{}
+	switch format {
+	case FormatJSON:
+		decoder := json.NewDecoder(reader)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&definitions); err != nil {
+			return err
+		}
+	case FormatYAML:
+		decoder := yaml.NewDecoder(reader)
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&definitions); err != nil && err != io.EOF {
+			return err
+		}
+	default:
+		return ErrUnsupportedFormat
+	}
+
+	currencies := make([]*Currency, 0, len(definitions))
+	for code, definition := range definitions {
+		if code == "" {
+			return ErrInvalidCurrency
+		}
+		registered, ok := r.Lookup(code)
+		if !ok {
+			registered = fallbackCurrency(code)
+		}
+		currency, err := definition.apply(registered)
+		if err != nil {
+			return err
+		}
+		currencies = append(currencies, currency)
+	}
+	for _, currency := range currencies {
+		if err := r.Register(currency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply returns a copy of currency with the definition applied, the registered currency is left untouched
+func (d currencyDefinition) apply(currency *Currency) (*Currency, error) {
+	gc := *currency.Currency
+	nc := &Currency{
+		Currency:             &gc,
+		smallestDenomination: currency.smallestDenomination,
+		cashDenomination:     currency.cashDenomination,
+		iso:                  currency.iso,
+	}
+	if d.Grapheme != nil {
+		nc.Grapheme = *d.Grapheme
+	}
+	if d.Template != nil {
+		nc.Template = *d.Template
+	}
+	if d.Decimal != nil {
+		nc.Decimal = *d.Decimal
+	}
+	if d.Thousand != nil {
+		nc.Thousand = *d.Thousand
+	}
+	if d.Fraction != nil {
+		if *d.Fraction < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFraction, nc.Code)
+		}
+		nc.Fraction = *d.Fraction
+	}
+	if d.SmallestDenomination != nil {
+		if *d.SmallestDenomination <= 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidDenomination, nc.Code)
+		}
+		nc.smallestDenomination = *d.SmallestDenomination
+	}
+	if d.CashDenomination != nil {
+		if *d.CashDenomination < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidDenomination, nc.Code)
+		}
+		nc.cashDenomination = *d.CashDenomination
+	}
+	return nc, nil
+}
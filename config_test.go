@@ -0,0 +1,107 @@
+package money
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadCurrencies(t *testing.T) {
+	testTable := []struct {
+		format Format
+		config string
+	}{
+		{
+			format: FormatJSON,
+			config: `{
+				"THB": {"grapheme": "THB ", "template": "$1"},
+				"TWD": {"fraction": 2, "smallest_denomination": 100},
+				"PTS": {"grapheme": " pts", "fraction": 0},
+				"CHF": {"cash_denomination": 10}
+			}`,
+		},
+		{
+			format: FormatYAML,
+			config: `
+THB:
+  grapheme: "THB "
+  template: "$1"
+TWD:
+  fraction: 2
+  smallest_denomination: 100
+PTS:
+  grapheme: " pts"
+  fraction: 0
+CHF:
+  cash_denomination: 10
+`,
+		},
+	}
+	for _, item := range testTable {
+		r := NewRegistry()
+		setCurrency(r, getCurrency("THB").Currency, 1)
+		setCurrency(r, getCurrency("TWD").Currency, 1)
+
+		assert.NoError(t, r.LoadCurrencies(strings.NewReader(item.config), item.format))
+
+		thb := New(100000, "THB", WithRegistry(r))
+		assert.Equal(t, "THB 1,000.00", thb.Label)
+
+		twd := NewFromAmount(28.55, "TWD", WithRegistry(r), WithRoundingMode(RoundUp))
+		assert.Equal(t, int64(2900), twd.Cents)
+		assert.Equal(t, "NT$29.00", twd.Label)
+
+		pts := New(1500, "PTS", WithRegistry(r))
+		assert.Equal(t, "1,500 pts", pts.Label)
+
+		chf, _, err := New(1234, "CHF", WithRegistry(r)).RoundForCash()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1230), chf.Cents)
+
+		// Definitions of the default registry and go-money are untouched
+		assert.Equal(t, "1,000.00 ฿", New(100000, "THB").Label)
+		assert.Equal(t, "NT$100,000", New(100000, "TWD").Label)
+		assert.Equal(t, int32(5), getCurrency("CHF").GetCashDenomination())
+	}
+}
+
+func TestLoadCurrencies_WithError(t *testing.T) {
+	testTable := []struct {
+		format   Format
+		config   string
+		expected error
+	}{
+		{
+			format:   FormatJSON,
+			config:   `{"TWD": {"fraction": -1}, "USD": {"fraction": 0}}`,
+			expected: ErrInvalidFraction,
+		},
+		{
+			format:   FormatYAML,
+			config:   "TWD:\n  smallest_denomination: 0\nUSD:\n  fraction: 0\n",
+			expected: ErrInvalidDenomination,
+		},
+		{
+			format:   FormatJSON,
+			config:   `{"CHF": {"cash_denomination": -5}, "USD": {"fraction": 0}}`,
+			expected: ErrInvalidDenomination,
+		},
+		{
+			format:   Format("toml"),
+			config:   "",
+			expected: ErrUnsupportedFormat,
+		},
+	}
+	for _, item := range testTable {
+		r := NewRegistry()
+		err := r.LoadCurrencies(strings.NewReader(item.config), item.format)
+		assert.ErrorIs(t, err, item.expected)
+		_, ok := r.Lookup("USD")
+		assert.False(t, ok)
+	}
+
+	r := NewRegistry()
+	assert.Error(t, r.LoadCurrencies(strings.NewReader(`{"TWD": {"fractions": 2}}`), FormatJSON))
+	assert.Error(t, r.LoadCurrencies(strings.NewReader("TWD:\n  fractions: 2\n"), FormatYAML))
+}
@@ -0,0 +1,81 @@
+package money
+
+import (
+	"math/big"
+)
+
+// RateProvider returns how many units of currency to are worth one unit of currency from
+type RateProvider interface {
+	Rate(from string, to string) (*big.Rat, error)
+}
+
+// RateProviderFunc adapts an ordinary function to RateProvider
+type RateProviderFunc func(from string, to string) (*big.Rat, error)
+
+func (f RateProviderFunc) Rate(from string, to string) (*big.Rat, error) {
+	return f(from, to)
+}
+
+// StaticRates is a RateProvider backed by a fixed table, keyed by the source then the target ISO code.
+// When only the opposite direction is listed, its inverse is used.
+type StaticRates map[string]map[string]*big.Rat
+
+func (s StaticRates) Rate(from string, to string) (*big.Rat, error) {
+	if rate, ok := s[from][to]; ok && rate != nil {
+		return rate, nil
+	}
+	if rate, ok := s[to][from]; ok && rate != nil && rate.Sign() != 0 {
+		return new(big.Rat).Inv(rate), nil
+	}
+	return nil, ErrRateNotFound
+}
+
+// Converter converts Money between currencies with rates from a RateProvider
+type Converter struct {
+	provider RateProvider
+	options  []MoneyOption
+}
+
+// NewConverter creates a Converter, options are applied to every converted Money
+// and take precedence over the rounding mode of the source Money.
+func NewConverter(provider RateProvider, options ...MoneyOption) *Converter {
+	return &Converter{
+		provider: provider,
+		options:  options,
+	}
+}
+
+// Convert returns m in the target currency. The amount is computed exactly and then rounded to the target currency's
+// fraction and smallest denomination, with the rounding mode of m unless the Converter sets one.
+// The target currency is resolved against the registry of m unless the Converter sets one.
+func (c *Converter) Convert(m *Money, targetIso string) (*Money, error) {
+	if c == nil || m == nil {
+		return nil, ErrNilMoney
+	}
+	options := append([]MoneyOption{WithRegistry(m.registry), WithRoundingMode(m.roundingMode), WithSymmetricRounding(m.symmetricRounding)}, c.options...)
+	if m.CurrencyIso == targetIso {
+		nm := New(m.Cents, targetIso, append(options, WithSmallestDenomination(m.smallestDenomination))...)
+		if err := nm.checkRoundingMode(); err != nil {
+			return nil, err
+		}
+		if err := nm.checkSmallestDenomination(); err != nil {
+			return nil, err
+		}
+		return nm, nil
+	}
+
+	rate, err := c.provider.Rate(m.CurrencyIso, targetIso)
+	if err != nil {
+		return nil, err
+	}
+	if rate == nil {
+		return nil, ErrRateNotFound
+	}
+	if rate.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+
+	currencyDecimals := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(m.resolveCurrency().Fraction)), nil)
+	amount := new(big.Rat).SetFrac(big.NewInt(m.Cents), currencyDecimals)
+	return NewFromRat(amount.Mul(amount, rate), targetIso, options...)
+}
@@ -0,0 +1,118 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	rates := StaticRates{
+		"USD": {
+			"TWD": big.NewRat(3215, 100),
+			"JPY": big.NewRat(14935, 100),
+		},
+		"HKD": {
+			"USD": big.NewRat(1282, 10000),
+		},
+	}
+	testTable := []struct {
+		cents     int64
+		from      string
+		to        string
+		roundMode RoundingMode
+		options   []MoneyOption
+		expected  int64
+	}{
+		{
+			cents:    1000,
+			from:     "USD",
+			to:       "TWD",
+			expected: 322,
+		},
+		{
+			cents:     1000,
+			from:      "USD",
+			to:        "TWD",
+			roundMode: RoundDown,
+			expected:  321,
+		},
+		{
+			cents:    1000,
+			from:     "USD",
+			to:       "TWD",
+			options:  []MoneyOption{WithRoundingMode(RoundUp)},
+			expected: 322,
+		},
+		{
+			cents:    1000,
+			from:     "USD",
+			to:       "TWD",
+			options:  []MoneyOption{WithSmallestDenomination(10)},
+			expected: 320,
+		},
+		{
+			cents:    199,
+			from:     "USD",
+			to:       "JPY",
+			expected: 297,
+		},
+		{
+			cents:    10000,
+			from:     "HKD",
+			to:       "USD",
+			expected: 1282,
+		},
+		{
+			cents:     1282,
+			from:      "USD",
+			to:        "HKD",
+			roundMode: RoundHalfUp,
+			expected:  10000,
+		},
+		{
+			cents:    1234,
+			from:     "USD",
+			to:       "USD",
+			expected: 1234,
+		},
+	}
+	for _, item := range testTable {
+		c := NewConverter(rates, item.options...)
+		m := New(item.cents, item.from, WithRoundingMode(item.roundMode))
+		nm, err := c.Convert(m, item.to)
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, nm.Cents)
+		assert.Equal(t, item.to, nm.CurrencyIso)
+	}
+}
+
+func TestConvert_WithError(t *testing.T) {
+	errProvider := errors.New("provider unavailable")
+	c := NewConverter(RateProviderFunc(func(from string, to string) (*big.Rat, error) {
+		switch to {
+		case "TWD":
+			return nil, errProvider
+		case "HKD":
+			return big.NewRat(0, 1), nil
+		case "SGD":
+			return nil, nil
+		}
+		return StaticRates{}.Rate(from, to)
+	}))
+	m := New(100, "USD")
+
+	_, err := c.Convert(m, "TWD")
+	assert.ErrorIs(t, err, errProvider)
+	_, err = c.Convert(m, "HKD")
+	assert.ErrorIs(t, err, ErrInvalidRate)
+	_, err = c.Convert(m, "JPY")
+	assert.ErrorIs(t, err, ErrRateNotFound)
+	_, err = c.Convert(m, "SGD")
+	assert.ErrorIs(t, err, ErrRateNotFound)
+
+	_, err = NewConverter(StaticRates{"USD": {"EUR": nil}, "EUR": {"USD": nil}}).Convert(m, "EUR")
+	assert.ErrorIs(t, err, ErrRateNotFound)
+}
@@ -1,53 +1,299 @@
 package money
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
 	gomoney "github.com/Rhymond/go-money"
 )
 
 type Currency struct {
 	*gomoney.Currency
 	smallestDenomination int32
+	cashDenomination     int32
+	iso                  isoCurrency
+	// unknown is set on currencies registered on the fly for codes neither go-money nor ISO 4217 knows
+	unknown bool
 }
 
-var currencies = map[string]*Currency{}
+type CurrencyOption func(*Currency)
 
-func setCurrency(currencies map[string]*Currency, currency *gomoney.Currency, smallestDenomination int32) {
-	currencies[currency.Code] = &Currency{
-		Currency:             currency,
-		smallestDenomination: smallestDenomination,
+func WithGrapheme(grapheme string) CurrencyOption {
+	return func(c *Currency) {
+		c.Grapheme = grapheme
 	}
 }
 
-func getCurrency(code string) *Currency {
-	if _, ok := currencies[code]; !ok {
-		currencies[code] = &Currency{
-			Currency:             gomoney.GetCurrency(code),
-			smallestDenomination: 1,
+func WithTemplate(template string) CurrencyOption {
+	return func(c *Currency) {
+		c.Template = template
+	}
+}
+
+func WithSeparators(decimal string, thousand string) CurrencyOption {
+	return func(c *Currency) {
+		c.Decimal = decimal
+		c.Thousand = thousand
+	}
+}
+
+func WithCurrencySmallestDenomination(smallestDenomination int32) CurrencyOption {
+	return func(c *Currency) {
+		c.smallestDenomination = smallestDenomination
+	}
+}
+
+// WithCashDenomination sets the smallest coin in cents when paying cash, e.g. 5 for CHF.
+// Zero means cash is rounded like electronic payments.
+func WithCashDenomination(cashDenomination int32) CurrencyOption {
+	return func(c *Currency) {
+		c.cashDenomination = cashDenomination
+	}
+}
+
+// NewCurrency creates a Currency which is formatted like go-money's default for unknown codes,
+// e.g. "1.00PTS", unless overridden by options
+func NewCurrency(code string, fraction int, options ...CurrencyOption) *Currency {
+	currency := &Currency{
+		Currency: &gomoney.Currency{
+			Code:     code,
+			Fraction: fraction,
+			Grapheme: code,
+			Template: "1$",
+			Decimal:  ".",
+			Thousand: ",",
+		},
+		smallestDenomination: 1,
+		iso:                  iso4217[code],
+	}
+	for _, option := range options {
+		option(currency)
+	}
+	return currency
+}
+
+func (c *Currency) GetSmallestDenomination() int32 {
+	return c.smallestDenomination
+}
+
+// GetCashDenomination returns the smallest coin in cents, or the smallest denomination if no cash denomination is set
+func (c *Currency) GetCashDenomination() int32 {
+	if c.cashDenomination == 0 {
+		return c.smallestDenomination
+	}
+	return c.cashDenomination
+}
+
+// NumericCode returns the ISO 4217 numeric code, e.g. "840" for USD
+func (c *Currency) NumericCode() string {
+	if c.iso.numericCode == "" {
+		return c.Currency.NumericCode
+	}
+	return c.iso.numericCode
+}
+
+// Name returns the ISO 4217 currency name, e.g. "US Dollar" for USD
+func (c *Currency) Name() string {
+	return c.iso.name
+}
+
+// MinorUnits returns the ISO 4217 minor units, which can differ from Fraction, e.g. 2 for TWD.
+// It returns UnknownMinorUnits for withdrawn currencies.
+func (c *Currency) MinorUnits() int {
+	return c.iso.minorUnits
+}
+
+// WithdrawalDate returns the "YYYY-MM" the currency was withdrawn from ISO 4217, or "" if it is current
+func (c *Currency) WithdrawalDate() string {
+	return c.iso.withdrawalDate
+}
+
+// Registry holds the currencies Money can be created with, it is safe for concurrent use
+type Registry struct {
+	mu         sync.RWMutex
+	currencies map[string]*Currency
+}
+
+var defaultRegistry = NewRegistry()
+
+func NewRegistry() *Registry {
+	return &Registry{
+		currencies: map[string]*Currency{},
+	}
+}
+
+// DefaultRegistry returns the registry used by Money created without WithRegistry
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register adds the currency, or replaces the one registered with the same code.
+// Money created before keeps the replaced definition.
+func (r *Registry) Register(currency *Currency) error {
+	if currency.Code == "" {
+		return ErrInvalidCurrency
+	}
+	if currency.smallestDenomination <= 0 || currency.cashDenomination < 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidDenomination, currency.Code)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.currencies[currency.Code] = currency
+	return nil
+}
+
+// Lookup returns the currency registered with code
+func (r *Registry) Lookup(code string) (*Currency, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	currency, ok := r.currencies[code]
+	return currency, ok
+}
+
+// LookupNumeric returns the currency of an ISO 4217 numeric code, e.g. "901" for TWD.
+// Codes known to ISO 4217 but not registered yet are registered like Money does on creation.
+func (r *Registry) LookupNumeric(numericCode string) (*Currency, bool) {
+	for _, currency := range r.List() {
+		if currency.NumericCode() == numericCode {
+			return currency, true
 		}
 	}
+	code, ok := lookupISO4217Numeric(numericCode)
+	if !ok {
+		return nil, false
+	}
+	return r.resolve(code), true
+}
+
+// List returns all registered currencies sorted by code
+func (r *Registry) List() []*Currency {
+	r.mu.RLock()
+	list := make([]*Currency, 0, len(r.currencies))
+	for _, currency := range r.currencies {
+		list = append(list, currency)
+	}
+	r.mu.RUnlock()
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Code < list[j].Code
+	})
+	return list
+}
 
-	return currencies[code]
+func (r *Registry) Unregister(code string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.currencies, code)
+}
+
+// resolve returns the currency registered with code. Unregistered codes are registered on the fly with fallbackCurrency,
+// except the empty code of Money without currency, which gets an unregistered fallbackCurrency.
+func (r *Registry) resolve(code string) *Currency {
+	if currency, ok := r.Lookup(code); ok {
+		return currency
+	}
+	if code == "" {
+		return fallbackCurrency(code)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Another goroutine may have registered the code while waiting for the lock
+	if currency, ok := r.currencies[code]; ok {
+		return currency
+	}
+	currency := fallbackCurrency(code)
+	r.currencies[code] = currency
+	return currency
+}
+
+// fallbackCurrency returns go-money's definition of code, or go-money's default formatting
+// with the ISO 4217 minor units if go-money does not know the code
+func fallbackCurrency(code string) *Currency {
+	fraction := 2
+	if iso, ok := iso4217[code]; ok && iso.minorUnits != UnknownMinorUnits {
+		fraction = iso.minorUnits
+	}
+	currency := NewCurrency(code, fraction)
+	if gc := gomoney.GetCurrency(code); gc != nil {
+		currency.Currency = gc
+	}
+	currency.unknown = !isKnownCode(code)
+	return currency
+}
+
+// resolveStrict is resolve rejecting codes that are neither registered, known to go-money nor to ISO 4217
+func (r *Registry) resolveStrict(code string) (*Currency, error) {
+	if currency, ok := r.Lookup(code); ok && !currency.unknown {
+		return currency, nil
+	}
+	if !isKnownCode(code) {
+		return nil, &UnknownCurrencyError{Code: code}
+	}
+	return r.resolve(code), nil
+}
+
+func isKnownCode(code string) bool {
+	if _, ok := iso4217[code]; ok {
+		return true
+	}
+	return gomoney.GetCurrency(code) != nil
+}
+
+// NormalizeCurrencyCode trims whitespace around code and upper-cases it, e.g. " usd " to "USD"
+func NormalizeCurrencyCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func setCurrency(registry *Registry, currency *gomoney.Currency, smallestDenomination int32) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	registry.currencies[currency.Code] = &Currency{
+		Currency:             currency,
+		smallestDenomination: smallestDenomination,
+		iso:                  iso4217[currency.Code],
+	}
+}
+
+func setCashDenomination(registry *Registry, code string, cashDenomination int32) {
+	currency := registry.resolve(code)
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	currency.cashDenomination = cashDenomination
+}
+
+func getCurrency(code string) *Currency {
+	return defaultRegistry.resolve(code)
 }
 
 func init() {
 	// Need to change Currency TWD Fraction from 2 to 0 in /Rhymond/go-money
-	setCurrency(currencies, gomoney.AddCurrency("HKD", "HK$", "$1", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("CNY", "CN\u00a5", "$1", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("TWD", "NT$", "$1", ".", ",", 0), 1)
-	setCurrency(currencies, gomoney.AddCurrency("USD", "US$", "$1", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("SGD", "S$", "$1", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("EUR", "\u20ac", "$1", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("AUD", "A$", "$1", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("GBP", "\u00a3", "$1", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("PHP", "PHP", "$1", ".", ",", 2), 1) // \u20b1
-	setCurrency(currencies, gomoney.AddCurrency("MYR", "RM", "$1", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("THB", "\u0e3f", "1 $", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("AED", "DH", "1$", ".", ",", 2), 1) //\u062f.\u0625
-	setCurrency(currencies, gomoney.AddCurrency("JPY", "円", "$1", ".", ",", 0), 1)  // \u00a5
-	setCurrency(currencies, gomoney.AddCurrency("MMK", "K", "$1", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("BND", "B$", "$1", ".", ",", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("KRW", "\u20a9", "$1", ".", ",", 0), 1)
-	setCurrency(currencies, gomoney.AddCurrency("IDR", "Rp", "$ 1", ",", ".", 2), 1)
-	setCurrency(currencies, gomoney.AddCurrency("VND", "\u20ab", "1 $", ".", ",", 0), 1)
-	setCurrency(currencies, gomoney.AddCurrency("CAD", "C$", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("HKD", "HK$", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("CNY", "CN\u00a5", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("TWD", "NT$", "$1", ".", ",", 0), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("USD", "US$", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("SGD", "S$", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("EUR", "\u20ac", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("AUD", "A$", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("GBP", "\u00a3", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("PHP", "PHP", "$1", ".", ",", 2), 1) // \u20b1
+	setCurrency(defaultRegistry, gomoney.AddCurrency("MYR", "RM", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("THB", "\u0e3f", "1 $", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("AED", "DH", "1$", ".", ",", 2), 1) //\u062f.\u0625
+	setCurrency(defaultRegistry, gomoney.AddCurrency("JPY", "円", "$1", ".", ",", 0), 1)  // \u00a5
+	setCurrency(defaultRegistry, gomoney.AddCurrency("MMK", "K", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("BND", "B$", "$1", ".", ",", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("KRW", "\u20a9", "$1", ".", ",", 0), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("IDR", "Rp", "$ 1", ",", ".", 2), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("VND", "\u20ab", "1 $", ".", ",", 0), 1)
+	setCurrency(defaultRegistry, gomoney.AddCurrency("CAD", "C$", "$1", ".", ",", 2), 1)
+
+	// Cash is paid in the smallest coin in circulation
+	setCashDenomination(defaultRegistry, "AUD", 5)
+	setCashDenomination(defaultRegistry, "CAD", 5)
+	setCashDenomination(defaultRegistry, "SGD", 5)
+	setCashDenomination(defaultRegistry, "CHF", 5)
+	setCashDenomination(defaultRegistry, "NZD", 10)
+	setCashDenomination(defaultRegistry, "SEK", 100)
 }
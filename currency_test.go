@@ -0,0 +1,131 @@
+package money
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCurrency(t *testing.T) {
+	c := NewCurrency("PTS", 0)
+	assert.Equal(t, "PTS", c.Code)
+	assert.Equal(t, "PTS", c.Grapheme)
+	assert.Equal(t, "1$", c.Template)
+	assert.Equal(t, int32(1), c.GetSmallestDenomination())
+
+	c = NewCurrency("NZD", 2, WithGrapheme("NZ$"), WithTemplate("$1"), WithSeparators(",", "."), WithCurrencySmallestDenomination(10))
+	assert.Equal(t, "NZ$", c.Grapheme)
+	assert.Equal(t, "$1", c.Template)
+	assert.Equal(t, ",", c.Decimal)
+	assert.Equal(t, ".", c.Thousand)
+	assert.Equal(t, int32(10), c.GetSmallestDenomination())
+	assert.Equal(t, int32(10), c.GetCashDenomination())
+
+	c = NewCurrency("CHF", 2, WithCashDenomination(5))
+	assert.Equal(t, int32(1), c.GetSmallestDenomination())
+	assert.Equal(t, int32(5), c.GetCashDenomination())
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	assert.NoError(t, r.Register(NewCurrency("PTS", 0, WithGrapheme(" pts"), WithTemplate("1$"))))
+	assert.NoError(t, r.Register(NewCurrency("NZD", 2, WithGrapheme("NZ$"), WithTemplate("$1"), WithCurrencySmallestDenomination(10))))
+
+	c, ok := r.Lookup("PTS")
+	assert.True(t, ok)
+	assert.Equal(t, "PTS", c.Code)
+	assert.Equal(t, []string{"NZD", "PTS"}, lo.Map(r.List(), func(c *Currency, _ int) string { return c.Code }))
+
+	r.Unregister("PTS")
+	_, ok = r.Lookup("PTS")
+	assert.False(t, ok)
+	assert.Len(t, r.List(), 1)
+}
+
+func TestRegistry_RegisterWithError(t *testing.T) {
+	r := NewRegistry()
+	assert.ErrorIs(t, r.Register(NewCurrency("", 2)), ErrInvalidCurrency)
+	assert.ErrorIs(t, r.Register(NewCurrency("NZD", 2, WithCurrencySmallestDenomination(0))), ErrInvalidDenomination)
+	assert.ErrorIs(t, r.Register(NewCurrency("CHF", 2, WithCashDenomination(-5))), ErrInvalidDenomination)
+	assert.Empty(t, r.List())
+}
+
+func TestNew_WithRegistry(t *testing.T) {
+	r := NewRegistry()
+	assert.NoError(t, r.Register(NewCurrency("PTS", 0, WithGrapheme(" pts"), WithTemplate("1$"))))
+	assert.NoError(t, r.Register(NewCurrency("NZD", 2, WithGrapheme("NZ$"), WithTemplate("$1"), WithCurrencySmallestDenomination(10))))
+
+	m := New(1500, "PTS", WithRegistry(r))
+	assert.Equal(t, "1,500 pts", m.Label)
+	assert.Equal(t, float64(1500), m.Dollars)
+	assert.Equal(t, " pts", m.CurrencySymbol)
+
+	nm, err := m.Add(New(500, "PTS", WithRegistry(r)))
+	assert.NoError(t, err)
+	assert.Equal(t, "2,000 pts", nm.Display())
+
+	nzd := NewFromAmount(12.34, "NZD", WithRegistry(r), WithRoundingMode(RoundHalfUp))
+	assert.Equal(t, int64(1230), nzd.Cents)
+	assert.Equal(t, int32(10), nzd.GetSmallestDenomination())
+	assert.Equal(t, "NZ$12.30", nzd.Label)
+
+	// The default registry is untouched
+	assert.Equal(t, "$12.34", NewFromAmount(12.34, "NZD").Label)
+}
+
+func TestNew_UnknownCurrency(t *testing.T) {
+	m := New(100, "XYZ")
+	assert.Equal(t, "XYZ", m.CurrencyIso)
+	assert.Equal(t, "1.00XYZ", m.Label)
+	assert.Equal(t, "1.00XYZ", m.Display())
+}
+
+func TestRegistry_Concurrency(t *testing.T) {
+	const workers = 64
+	codes := lo.Times(workers, func(i int) string { return fmt.Sprintf("X%02d", i%16) })
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(code string) {
+			defer wg.Done()
+			m := New(100, code)
+			assert.Equal(t, code, m.CurrencyIso)
+
+			am := NewFromAmount(1.5, code, WithRoundingMode(RoundUp))
+			assert.Equal(t, int64(150), am.Cents)
+
+			um := &Money{Cents: 100, CurrencyIso: code}
+			assert.Equal(t, code, um.GetCurrency().Code)
+		}(codes[i])
+	}
+	wg.Wait()
+
+	// All goroutines resolved the same currency for a code
+	for _, code := range codes {
+		c, ok := DefaultRegistry().Lookup(code)
+		assert.True(t, ok)
+		assert.Same(t, c, New(0, code).GetCurrency())
+	}
+}
+
+func TestRegistry_ConcurrentRegister(t *testing.T) {
+	r := NewRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 64; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			code := fmt.Sprintf("P%02d", i%8)
+			assert.NoError(t, r.Register(NewCurrency(code, 0)))
+			m := New(int64(i), code, WithRegistry(r))
+			assert.Equal(t, code, m.CurrencyIso)
+			r.List()
+			r.Unregister(code)
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,188 @@
+package money
+
+import (
+	"math/big"
+	"regexp"
+
+	gomoney "github.com/Rhymond/go-money"
+)
+
+// NewFromDecimal creates Money from an amount in major units written as a plain decimal string, e.g. "1234.5".
+// Fractions, exponents and hexadecimal are rejected with ErrInvalidDecimal.
+// The amount is rounded exactly with the rounding mode and smallest denomination set in options.
+func NewFromDecimal(amount string, isoCode string, options ...MoneyOption) (*Money, error) {
+	r, err := parseDecimal(amount)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromRat(r, isoCode, options...)
+}
+
+// NewFromRat creates Money from an amount in major units.
+// The amount is rounded exactly with the rounding mode and smallest denomination set in options.
+func NewFromRat(amount *big.Rat, isoCode string, options ...MoneyOption) (*Money, error) {
+	// Temp money object without value
+	money := newFromGoMoney(gomoney.New(0, isoCode), options...)
+
+	currencyDecimals := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(money.resolveCurrency().Fraction)), nil)
+	cents := new(big.Rat).Mul(amount, new(big.Rat).SetInt(currencyDecimals))
+	rounded, err := money.roundRat(cents)
+	if err != nil {
+		return nil, err
+	}
+	return New(rounded, isoCode, options...), nil
+}
+
+// MultiplyDecimal returns new Money struct with value representing Self multiplied by a decimal string, e.g. "1.075".
+// The product is computed exactly before the rounding mode and smallest denomination are applied.
+func (m *Money) MultiplyDecimal(mul string) (*Money, error) {
+	r, err := parseDecimal(mul)
+	if err != nil {
+		return nil, err
+	}
+	return m.MultiplyRat(r)
+}
+
+// MultiplyRat returns new Money struct with value representing Self multiplied by mul.
+// The product is computed exactly before the rounding mode and smallest denomination are applied.
+func (m *Money) MultiplyRat(mul *big.Rat) (*Money, error) {
+	return filled(m.multiplyRat(mul))
+}
+
+// multiplyRat is MultiplyRat leaving Label and Dollars empty, for results that are not returned to callers
+func (m *Money) multiplyRat(mul *big.Rat) (*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+	cents := new(big.Rat).SetInt64(m.Cents)
+	rounded, err := m.roundRat(cents.Mul(cents, mul))
+	if err != nil {
+		return nil, err
+	}
+	return m.withCents(rounded), nil
+}
+
+// DivideDecimal returns new Money struct with value representing Self divided by a decimal string, e.g. "1.05".
+// The quotient is computed exactly before the rounding mode and smallest denomination are applied.
+func (m *Money) DivideDecimal(div string) (*Money, error) {
+	r, err := parseDecimal(div)
+	if err != nil {
+		return nil, err
+	}
+	return m.DivideRat(r)
+}
+
+// DivideRat returns new Money struct with value representing Self divided by div.
+// The quotient is computed exactly before the rounding mode and smallest denomination are applied.
+func (m *Money) DivideRat(div *big.Rat) (*Money, error) {
+	return filled(m.divideRat(div))
+}
+
+// divideRat is DivideRat leaving Label and Dollars empty, for results that are not returned to callers
+func (m *Money) divideRat(div *big.Rat) (*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+	if div.Sign() == 0 {
+		return nil, ErrDivideByZero
+	}
+	return m.multiplyRat(new(big.Rat).Inv(div))
+}
+
+// Round cents exactly with rounding mode and smallest denomination set
+func (m *Money) roundRat(cents *big.Rat) (int64, error) {
+	if err := m.checkSmallestDenomination(); err != nil {
+		return 0, err
+	}
+	smallestDenomination := int64(m.smallestDenomination)
+	if smallestDenomination == 0 {
+		smallestDenomination = int64(m.resolveCurrency().smallestDenomination)
+	}
+	return m.roundRatToDenomination(cents, smallestDenomination)
+}
+
+// Round cents exactly to a multiple of denomination with rounding mode set
+func (m *Money) roundRatToDenomination(cents *big.Rat, denomination int64) (int64, error) {
+	if err := m.checkRoundingMode(); err != nil {
+		return 0, err
+	}
+	value := new(big.Rat).Quo(cents, new(big.Rat).SetInt64(denomination))
+	var rounded *big.Int
+	if m.symmetricRounding && value.Sign() < 0 {
+		rounded = roundRatWithExplicitMode(value.Neg(value), m.roundingMode)
+		rounded.Neg(rounded)
+	} else {
+		rounded = roundRatWithExplicitMode(value, m.roundingMode)
+	}
+	rounded.Mul(rounded, big.NewInt(denomination))
+	if !rounded.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return rounded.Int64(), nil
+}
+
+// roundRatWithExplicitMode is the exact counterpart of roundCentsWithExplicitMode
+func roundRatWithExplicitMode(value *big.Rat, mode RoundingMode) *big.Int {
+	// Euclidean division, so quotient is the floor of value and remainder is non-negative
+	floor, remainder := new(big.Int).DivMod(value.Num(), value.Denom(), new(big.Int))
+	if remainder.Sign() == 0 {
+		return floor
+	}
+	ceil := new(big.Int).Add(floor, big.NewInt(1))
+	half := new(big.Int).Lsh(remainder, 1).Cmp(value.Denom())
+	positive := value.Sign() > 0
+
+	switch mode {
+	case RoundUp:
+		return ceil
+	case RoundDown:
+		return floor
+	case RoundHalfUp, RoundHalfAwayFromZero:
+		// Same as math.Round, half is rounded away from zero
+		if half > 0 || (half == 0 && positive) {
+			return ceil
+		}
+		return floor
+	case RoundHalfDown:
+		if half > 0 || (half == 0 && !positive) {
+			return ceil
+		}
+		return floor
+	case RoundHalfOdd:
+		if half > 0 || (half == 0 && floor.Bit(0) == 0) {
+			return ceil
+		}
+		return floor
+	case RoundTowardZero:
+		if positive {
+			return floor
+		}
+		return ceil
+	case RoundAwayFromZero:
+		if positive {
+			return ceil
+		}
+		return floor
+	default:
+		// RoundBankers, half is rounded to the even neighbour
+		if half > 0 || (half == 0 && floor.Bit(0) == 1) {
+			return ceil
+		}
+		return floor
+	}
+}
+
+// decimalPattern is a plain decimal, big.Rat also accepts fractions, exponents and hexadecimal which are not amounts
+var decimalPattern = regexp.MustCompile(`^[-+]?[0-9]+(\.[0-9]+)?$`)
+
+// parseDecimal parses a plain decimal like "-1234.5", anything else returns ErrInvalidDecimal
+func parseDecimal(value string) (*big.Rat, error) {
+	if !decimalPattern.MatchString(value) {
+		return nil, ErrInvalidDecimal
+	}
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, ErrInvalidDecimal
+	}
+	return r, nil
+}
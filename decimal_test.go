@@ -0,0 +1,153 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFromDecimal(t *testing.T) {
+	testTable := []struct {
+		amount    string
+		currency  string
+		roundMode RoundingMode
+		expected  int64
+	}{
+		{
+			amount:   "28.55",
+			currency: "USD",
+			expected: 2855,
+		},
+		{
+			amount:    "28.55",
+			currency:  "TWD",
+			roundMode: RoundUp,
+			expected:  29,
+		},
+		{
+			amount:    "0.125",
+			currency:  "HKD",
+			roundMode: RoundHalfUp,
+			expected:  13,
+		},
+		{
+			amount:    "0.125",
+			currency:  "HKD",
+			roundMode: RoundBankers,
+			expected:  12,
+		},
+		{
+			amount:   "9007199254740993",
+			currency: "VND",
+			expected: 9007199254740993,
+		},
+	}
+	for _, item := range testTable {
+		m, err := NewFromDecimal(item.amount, item.currency, WithRoundingMode(item.roundMode))
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, m.Cents, item.amount)
+	}
+
+	for _, amount := range []string{"1,234", "1/3", "1e3", "0x10", "", ".5", "5.", "1.2.3", " 1"} {
+		_, err := NewFromDecimal(amount, "USD")
+		assert.ErrorIs(t, err, ErrInvalidDecimal, amount)
+	}
+}
+
+func TestMultiplyDecimal(t *testing.T) {
+	testTable := []struct {
+		cents                int64
+		multiplier           string
+		roundMode            RoundingMode
+		smallestDenomination int32
+		expected             int64
+	}{
+		{
+			cents:      1000,
+			multiplier: "1.075",
+			roundMode:  RoundBankers,
+			expected:   1075,
+		},
+		{
+			cents:      150,
+			multiplier: "0.07",
+			roundMode:  RoundBankers,
+			expected:   10,
+		},
+		{
+			cents:      150,
+			multiplier: "0.07",
+			roundMode:  RoundHalfUp,
+			expected:   11,
+		},
+		{
+			cents:      -150,
+			multiplier: "0.07",
+			roundMode:  RoundHalfUp,
+			expected:   -11,
+		},
+		{
+			cents:      -150,
+			multiplier: "0.07",
+			roundMode:  RoundUp,
+			expected:   -10,
+		},
+		{
+			cents:      -150,
+			multiplier: "0.07",
+			roundMode:  RoundDown,
+			expected:   -11,
+		},
+		{
+			cents:                1234,
+			multiplier:           "1",
+			roundMode:            RoundHalfUp,
+			smallestDenomination: 100,
+			expected:             1200,
+		},
+		{
+			cents:      9007199254740993,
+			multiplier: "1",
+			roundMode:  RoundBankers,
+			expected:   9007199254740993,
+		},
+	}
+	for _, item := range testTable {
+		m := New(item.cents, "IDR", WithRoundingMode(item.roundMode), WithSmallestDenomination(item.smallestDenomination))
+		nm, err := m.MultiplyDecimal(item.multiplier)
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, nm.Cents)
+		assert.Equal(t, item.roundMode, nm.GetRoundingMode())
+	}
+}
+
+func TestMultiplyRat_WithError(t *testing.T) {
+	m := New(math.MaxInt64, "VND")
+	_, err := m.MultiplyRat(big.NewRat(2, 1))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	for _, mul := range []string{"abc", "0x10", "1/3", "1e3"} {
+		_, err = m.MultiplyDecimal(mul)
+		assert.ErrorIs(t, err, ErrInvalidDecimal, mul)
+	}
+
+	nm, err := New(100, "IDR", WithRoundingMode(RoundUp)).MultiplyRat(big.NewRat(1, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(34), nm.Cents)
+}
+
+func TestDivideDecimal(t *testing.T) {
+	m := New(10500, "TWD", WithRoundingMode(RoundBankers))
+	nm, err := m.DivideDecimal("1.05")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10000), nm.Cents)
+
+	nm, err = m.DivideRat(big.NewRat(4, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2625), nm.Cents)
+
+	_, err = m.DivideDecimal("0")
+	assert.ErrorIs(t, err, ErrorDivideByZero)
+}
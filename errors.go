@@ -0,0 +1,46 @@
+package money
+
+import (
+	"fmt"
+)
+
+// CurrencyMismatchError is returned when an operation involves Money of different currencies.
+// It matches ErrCurrencyMismatch, and so gomoney.ErrCurrencyMismatch, with errors.Is.
+type CurrencyMismatchError struct {
+	// Expected is the currency of the receiver or of the first Money of the operation
+	Expected string
+	// Actual is the first currency found that differs from Expected
+	Actual string
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("%s: %s and %s", ErrCurrencyMismatch, e.Expected, e.Actual)
+}
+
+func (e *CurrencyMismatchError) Unwrap() error {
+	return ErrCurrencyMismatch
+}
+
+// checkCurrency returns CurrencyMismatchError for the first of ms whose currency differs from m
+func checkCurrency(m *Money, ms ...*Money) error {
+	for _, om := range ms {
+		if om.CurrencyIso != m.CurrencyIso {
+			return &CurrencyMismatchError{Expected: m.CurrencyIso, Actual: om.CurrencyIso}
+		}
+	}
+	return nil
+}
+
+// UnknownCurrencyError is returned by strict constructors for codes that are neither registered, known to go-money
+// nor to ISO 4217. It matches ErrUnknownCurrency with errors.Is.
+type UnknownCurrencyError struct {
+	Code string
+}
+
+func (e *UnknownCurrencyError) Error() string {
+	return fmt.Sprintf("%s: %q", ErrUnknownCurrency, e.Code)
+}
+
+func (e *UnknownCurrencyError) Unwrap() error {
+	return ErrUnknownCurrency
+}
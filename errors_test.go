@@ -0,0 +1,70 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	gomoney "github.com/Rhymond/go-money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyMismatchError(t *testing.T) {
+	testTable := []struct {
+		err      error
+		expected *CurrencyMismatchError
+	}{
+		{
+			err:      errOf(New(100, "TWD").Add(New(100, "TWD"), New(100, "USD"))),
+			expected: &CurrencyMismatchError{Expected: "TWD", Actual: "USD"},
+		},
+		{
+			err:      errOf(New(100, "USD").LessThan(New(100, "JPY"))),
+			expected: &CurrencyMismatchError{Expected: "USD", Actual: "JPY"},
+		},
+		{
+			err:      errOf(Compare(New(100, "HKD"), New(100, "USD"))),
+			expected: &CurrencyMismatchError{Expected: "HKD", Actual: "USD"},
+		},
+		{
+			err:      errOf(Sum(New(100, "HKD"), New(100, "HKD"), New(100, "SGD"))),
+			expected: &CurrencyMismatchError{Expected: "HKD", Actual: "SGD"},
+		},
+		{
+			err:      NewAccumulator("VND").Subtract(New(100, "USD")),
+			expected: &CurrencyMismatchError{Expected: "VND", Actual: "USD"},
+		},
+	}
+	for _, item := range testTable {
+		assert.ErrorIs(t, item.err, ErrCurrencyMismatch)
+		assert.ErrorIs(t, item.err, gomoney.ErrCurrencyMismatch)
+		var mismatch *CurrencyMismatchError
+		assert.True(t, errors.As(item.err, &mismatch))
+		assert.Equal(t, item.expected, mismatch)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	_, err := New(100, "USD").Divide(0)
+	assert.ErrorIs(t, err, ErrDivideByZero)
+	assert.ErrorIs(t, err, ErrorDivideByZero)
+
+	_, err = New(math.MaxInt64, "USD").Add(New(1, "USD"))
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = ParseRoundingMode("ROUND_SIDEWAYS")
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	assert.EqualError(t, err, `invalid rounding mode: "ROUND_SIDEWAYS"`)
+
+	err = NewRegistry().Register(NewCurrency("NZD", 2, WithCurrencySmallestDenomination(-10)))
+	assert.ErrorIs(t, err, ErrInvalidDenomination)
+	assert.EqualError(t, err, "invalid currency: smallest denomination must be higher than zero: NZD")
+
+	_, err = ParseAny("12.34")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+}
+
+// errOf drops the result of a call returning a value and an error
+func errOf[T any](_ T, err error) error {
+	return err
+}
@@ -0,0 +1,177 @@
+//go:build ignore
+// +build ignore
+
+// Generates iso4217_data.go from the ISO 4217 lists published by SIX, the ISO 4217 maintenance agency.
+//
+// List one holds the current currencies and their minor units, list three the historic ones and their withdrawal dates.
+// ISO does not publish minor units of withdrawn currencies, they are generated as UnknownMinorUnits.
+//
+//	go run gen_iso4217.go
+//
+// The lists are downloaded from SIX unless local copies are given:
+//
+//	go run gen_iso4217.go -list-one list-one.xml -list-three list-three.xml
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	listOneURL     = "https://www.six-group.com/dam/download/financial-information/data-center/iso-currrency/lists/list-one.xml"
+	listThreeURL   = "https://www.six-group.com/dam/download/financial-information/data-center/iso-currrency/lists/list-three.xml"
+	outputFilename = "iso4217_data.go"
+)
+
+type entry struct {
+	Code           string `xml:"Ccy"`
+	NumericCode    string `xml:"CcyNbr"`
+	Name           string `xml:"CcyNm"`
+	MinorUnits     string `xml:"CcyMnrUnts"`
+	WithdrawalDate string `xml:"WthdrwlDt"`
+}
+
+type list struct {
+	Entries []entry `xml:"CcyTbl>CcyNtry"`
+}
+
+type historicList struct {
+	Entries []entry `xml:"HstrcCcyTbl>HstrcCcyNtry"`
+}
+
+type currency struct {
+	numericCode    string
+	minorUnits     int
+	name           string
+	withdrawalDate string
+}
+
+// unknownMinorUnits is the value of the UnknownMinorUnits constant in package money
+const unknownMinorUnits = -1
+
+func main() {
+	listOne := flag.String("list-one", listOneURL, "URL or path of ISO 4217 list one")
+	listThree := flag.String("list-three", listThreeURL, "URL or path of ISO 4217 list three")
+	flag.Parse()
+
+	var current list
+	load(*listOne, &current)
+	var historic historicList
+	load(*listThree, &historic)
+
+	currencies := map[string]currency{}
+	for _, e := range current.Entries {
+		if e.Code == "" {
+			continue
+		}
+		// "N.A." is published for funds and precious metals
+		minorUnits, err := strconv.Atoi(e.MinorUnits)
+		if err != nil {
+			minorUnits = 0
+		}
+		currencies[e.Code] = currency{
+			numericCode: e.NumericCode,
+			minorUnits:  minorUnits,
+			name:        strings.TrimSpace(e.Name),
+		}
+	}
+	for _, e := range historic.Entries {
+		if e.Code == "" {
+			continue
+		}
+		// A code withdrawn in several countries is listed once per country, the latest withdrawal wins
+		date := withdrawalMonth(e.WithdrawalDate)
+		if c, ok := currencies[e.Code]; ok && (c.withdrawalDate == "" || c.withdrawalDate >= date) {
+			continue
+		}
+		currencies[e.Code] = currency{
+			numericCode:    e.NumericCode,
+			minorUnits:     unknownMinorUnits,
+			name:           strings.TrimSpace(e.Name),
+			withdrawalDate: date,
+		}
+	}
+
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_iso4217.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package money\n\n")
+	buf.WriteString("var iso4217 = map[string]isoCurrency{\n")
+	for _, code := range codes {
+		c := currencies[code]
+		minorUnits := strconv.Itoa(c.minorUnits)
+		if c.minorUnits == unknownMinorUnits {
+			minorUnits = "UnknownMinorUnits"
+		}
+		fmt.Fprintf(&buf, "\t%q: {numericCode: %q, minorUnits: %s, name: %q", code, c.numericCode, minorUnits, c.name)
+		if c.withdrawalDate != "" {
+			fmt.Fprintf(&buf, ", withdrawalDate: %q", c.withdrawalDate)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(outputFilename, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// load decodes the list at source, a URL or a local path
+func load(source string, v interface{}) {
+	var r io.Reader
+	if strings.HasPrefix(source, "https://") || strings.HasPrefix(source, "http://") {
+		resp, err := http.Get(source)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			log.Fatalf("%s: %s", source, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(source)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		r = f
+	}
+	if err := xml.NewDecoder(r).Decode(v); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// withdrawalMonth returns the "YYYY-MM" a currency was withdrawn. Dates can be ranges like "1989 to 1990" or
+// "1990-07 to 1990-09", the end of the range is kept. A year alone becomes December of that year.
+func withdrawalMonth(date string) string {
+	fields := strings.Fields(date)
+	if len(fields) == 0 {
+		return ""
+	}
+	last := fields[len(fields)-1]
+	if len(last) == len("2006") {
+		return last + "-12"
+	}
+	return last
+}
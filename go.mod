@@ -3,12 +3,15 @@ module github.com/shoplineapp/go-money
 go 1.18
 
 require (
-	github.com/Rhymond/go-money v1.0.9 // indirect
+	github.com/Rhymond/go-money v1.0.9
+	github.com/samber/lo v1.33.0
+	github.com/stretchr/testify v1.8.0
+	go.mongodb.org/mongo-driver v1.17.6
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/samber/lo v1.33.0 // indirect
-	github.com/stretchr/objx v0.4.0 // indirect
-	github.com/stretchr/testify v1.8.0 // indirect
 	golang.org/x/exp v0.0.0-20220303212507-bbda1eaf7a17 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
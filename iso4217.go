@@ -0,0 +1,31 @@
+package money
+
+//go:generate go run gen_iso4217.go
+
+// UnknownMinorUnits is returned by Currency.MinorUnits for withdrawn currencies, ISO 4217 does not publish their minor units
+const UnknownMinorUnits = -1
+
+// isoCurrency is a currency as published in ISO 4217
+type isoCurrency struct {
+	numericCode    string
+	minorUnits     int
+	name           string
+	withdrawalDate string
+}
+
+// lookupISO4217Numeric returns the alphabetic code of a numeric code, current currencies are preferred over withdrawn ones
+func lookupISO4217Numeric(numericCode string) (string, bool) {
+	found := ""
+	for code, iso := range iso4217 {
+		if iso.numericCode != numericCode {
+			continue
+		}
+		if iso.withdrawalDate == "" {
+			return code, true
+		}
+		if found == "" || iso.withdrawalDate > iso4217[found].withdrawalDate {
+			found = code
+		}
+	}
+	return found, found != ""
+}
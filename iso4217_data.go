@@ -0,0 +1,308 @@
+// Code generated by gen_iso4217.go; DO NOT EDIT.
+
+package money
+
+var iso4217 = map[string]isoCurrency{
+	"ADP": {numericCode: "020", minorUnits: UnknownMinorUnits, name: "Andorran Peseta", withdrawalDate: "2003-07"},
+	"AED": {numericCode: "784", minorUnits: 2, name: "UAE Dirham"},
+	"AFA": {numericCode: "004", minorUnits: UnknownMinorUnits, name: "Afghani", withdrawalDate: "2003-01"},
+	"AFN": {numericCode: "971", minorUnits: 2, name: "Afghani"},
+	"ALK": {numericCode: "008", minorUnits: UnknownMinorUnits, name: "Old Lek", withdrawalDate: "1989-12"},
+	"ALL": {numericCode: "008", minorUnits: 2, name: "Lek"},
+	"AMD": {numericCode: "051", minorUnits: 2, name: "Armenian Dram"},
+	"ANG": {numericCode: "532", minorUnits: 2, name: "Netherlands Antillean Guilder"},
+	"AOA": {numericCode: "973", minorUnits: 2, name: "Kwanza"},
+	"AOK": {numericCode: "024", minorUnits: UnknownMinorUnits, name: "Kwanza", withdrawalDate: "1991-03"},
+	"AON": {numericCode: "024", minorUnits: UnknownMinorUnits, name: "New Kwanza", withdrawalDate: "2000-02"},
+	"AOR": {numericCode: "982", minorUnits: UnknownMinorUnits, name: "Kwanza Reajustado", withdrawalDate: "2000-02"},
+	"ARA": {numericCode: "032", minorUnits: UnknownMinorUnits, name: "Austral", withdrawalDate: "1992-01"},
+	"ARP": {numericCode: "032", minorUnits: UnknownMinorUnits, name: "Peso Argentino", withdrawalDate: "1985-07"},
+	"ARS": {numericCode: "032", minorUnits: 2, name: "Argentine Peso"},
+	"ARY": {numericCode: "032", minorUnits: UnknownMinorUnits, name: "Peso", withdrawalDate: "1990-12"},
+	"ATS": {numericCode: "040", minorUnits: UnknownMinorUnits, name: "Schilling", withdrawalDate: "2002-03"},
+	"AUD": {numericCode: "036", minorUnits: 2, name: "Australian Dollar"},
+	"AWG": {numericCode: "533", minorUnits: 2, name: "Aruban Florin"},
+	"AYM": {numericCode: "945", minorUnits: UnknownMinorUnits, name: "Azerbaijan Manat", withdrawalDate: "2005-10"},
+	"AZM": {numericCode: "031", minorUnits: UnknownMinorUnits, name: "Azerbaijanian Manat", withdrawalDate: "2005-12"},
+	"AZN": {numericCode: "944", minorUnits: 2, name: "Azerbaijan Manat"},
+	"BAD": {numericCode: "070", minorUnits: UnknownMinorUnits, name: "Dinar", withdrawalDate: "1998-07"},
+	"BAM": {numericCode: "977", minorUnits: 2, name: "Convertible Mark"},
+	"BBD": {numericCode: "052", minorUnits: 2, name: "Barbados Dollar"},
+	"BDT": {numericCode: "050", minorUnits: 2, name: "Taka"},
+	"BEC": {numericCode: "993", minorUnits: UnknownMinorUnits, name: "Convertible Franc", withdrawalDate: "1990-03"},
+	"BEF": {numericCode: "056", minorUnits: UnknownMinorUnits, name: "Belgian Franc", withdrawalDate: "2002-03"},
+	"BEL": {numericCode: "992", minorUnits: UnknownMinorUnits, name: "Financial Franc", withdrawalDate: "1990-03"},
+	"BGJ": {numericCode: "100", minorUnits: UnknownMinorUnits, name: "Lev A/52", withdrawalDate: "1990-12"},
+	"BGK": {numericCode: "100", minorUnits: UnknownMinorUnits, name: "Lev A/62", withdrawalDate: "1990-12"},
+	"BGL": {numericCode: "100", minorUnits: UnknownMinorUnits, name: "Lev", withdrawalDate: "2003-11"},
+	"BGN": {numericCode: "975", minorUnits: 2, name: "Bulgarian Lev"},
+	"BHD": {numericCode: "048", minorUnits: 3, name: "Bahraini Dinar"},
+	"BIF": {numericCode: "108", minorUnits: 0, name: "Burundi Franc"},
+	"BMD": {numericCode: "060", minorUnits: 2, name: "Bermudian Dollar"},
+	"BND": {numericCode: "096", minorUnits: 2, name: "Brunei Dollar"},
+	"BOB": {numericCode: "068", minorUnits: 2, name: "Boliviano"},
+	"BOP": {numericCode: "068", minorUnits: UnknownMinorUnits, name: "Peso boliviano", withdrawalDate: "1987-02"},
+	"BOV": {numericCode: "984", minorUnits: 2, name: "Mvdol"},
+	"BRB": {numericCode: "076", minorUnits: UnknownMinorUnits, name: "Cruzeiro", withdrawalDate: "1986-03"},
+	"BRC": {numericCode: "076", minorUnits: UnknownMinorUnits, name: "Cruzado", withdrawalDate: "1989-02"},
+	"BRE": {numericCode: "076", minorUnits: UnknownMinorUnits, name: "Cruzeiro", withdrawalDate: "1993-03"},
+	"BRL": {numericCode: "986", minorUnits: 2, name: "Brazilian Real"},
+	"BRN": {numericCode: "076", minorUnits: UnknownMinorUnits, name: "New Cruzado", withdrawalDate: "1990-03"},
+	"BRR": {numericCode: "987", minorUnits: UnknownMinorUnits, name: "Cruzeiro Real", withdrawalDate: "1994-07"},
+	"BSD": {numericCode: "044", minorUnits: 2, name: "Bahamian Dollar"},
+	"BTN": {numericCode: "064", minorUnits: 2, name: "Ngultrum"},
+	"BUK": {numericCode: "104", minorUnits: UnknownMinorUnits, name: "Kyat", withdrawalDate: "1990-02"},
+	"BWP": {numericCode: "072", minorUnits: 2, name: "Pula"},
+	"BYB": {numericCode: "112", minorUnits: UnknownMinorUnits, name: "Belarusian Ruble", withdrawalDate: "2001-01"},
+	"BYN": {numericCode: "933", minorUnits: 2, name: "Belarusian Ruble"},
+	"BYR": {numericCode: "974", minorUnits: UnknownMinorUnits, name: "Belarusian Ruble", withdrawalDate: "2017-01"},
+	"BZD": {numericCode: "084", minorUnits: 2, name: "Belize Dollar"},
+	"CAD": {numericCode: "124", minorUnits: 2, name: "Canadian Dollar"},
+	"CDF": {numericCode: "976", minorUnits: 2, name: "Congolese Franc"},
+	"CHC": {numericCode: "948", minorUnits: UnknownMinorUnits, name: "WIR Franc (for electronic)", withdrawalDate: "2004-11"},
+	"CHE": {numericCode: "947", minorUnits: 2, name: "WIR Euro"},
+	"CHF": {numericCode: "756", minorUnits: 2, name: "Swiss Franc"},
+	"CHW": {numericCode: "948", minorUnits: 2, name: "WIR Franc"},
+	"CLF": {numericCode: "990", minorUnits: 4, name: "Unidad de Fomento"},
+	"CLP": {numericCode: "152", minorUnits: 0, name: "Chilean Peso"},
+	"CNY": {numericCode: "156", minorUnits: 2, name: "Yuan Renminbi"},
+	"COP": {numericCode: "170", minorUnits: 2, name: "Colombian Peso"},
+	"COU": {numericCode: "970", minorUnits: 2, name: "Unidad de Valor Real"},
+	"CRC": {numericCode: "188", minorUnits: 2, name: "Costa Rican Colon"},
+	"CSD": {numericCode: "891", minorUnits: UnknownMinorUnits, name: "Serbian Dinar", withdrawalDate: "2006-10"},
+	"CSJ": {numericCode: "203", minorUnits: UnknownMinorUnits, name: "Krona A/53", withdrawalDate: "1990-12"},
+	"CSK": {numericCode: "200", minorUnits: UnknownMinorUnits, name: "Koruna", withdrawalDate: "1993-03"},
+	"CUC": {numericCode: "931", minorUnits: 2, name: "Peso Convertible"},
+	"CUP": {numericCode: "192", minorUnits: 2, name: "Cuban Peso"},
+	"CVE": {numericCode: "132", minorUnits: 2, name: "Cabo Verde Escudo"},
+	"CYP": {numericCode: "196", minorUnits: UnknownMinorUnits, name: "Cyprus Pound", withdrawalDate: "2008-01"},
+	"CZK": {numericCode: "203", minorUnits: 2, name: "Czech Koruna"},
+	"DDM": {numericCode: "278", minorUnits: UnknownMinorUnits, name: "Mark der DDR", withdrawalDate: "1990-09"},
+	"DEM": {numericCode: "276", minorUnits: UnknownMinorUnits, name: "Deutsche Mark", withdrawalDate: "2002-03"},
+	"DJF": {numericCode: "262", minorUnits: 0, name: "Djibouti Franc"},
+	"DKK": {numericCode: "208", minorUnits: 2, name: "Danish Krone"},
+	"DOP": {numericCode: "214", minorUnits: 2, name: "Dominican Peso"},
+	"DZD": {numericCode: "012", minorUnits: 2, name: "Algerian Dinar"},
+	"ECS": {numericCode: "218", minorUnits: UnknownMinorUnits, name: "Sucre", withdrawalDate: "2000-09"},
+	"ECV": {numericCode: "983", minorUnits: UnknownMinorUnits, name: "Unidad de Valor Constante (UVC)", withdrawalDate: "2000-09"},
+	"EEK": {numericCode: "233", minorUnits: UnknownMinorUnits, name: "Kroon", withdrawalDate: "2011-01"},
+	"EGP": {numericCode: "818", minorUnits: 2, name: "Egyptian Pound"},
+	"ERN": {numericCode: "232", minorUnits: 2, name: "Nakfa"},
+	"ESA": {numericCode: "996", minorUnits: UnknownMinorUnits, name: "Spanish Peseta", withdrawalDate: "1981-12"},
+	"ESB": {numericCode: "995", minorUnits: UnknownMinorUnits, name: "\"A\" Account (convertible Peseta Account)", withdrawalDate: "1994-12"},
+	"ESP": {numericCode: "724", minorUnits: UnknownMinorUnits, name: "Spanish Peseta", withdrawalDate: "2002-03"},
+	"ETB": {numericCode: "230", minorUnits: 2, name: "Ethiopian Birr"},
+	"EUR": {numericCode: "978", minorUnits: 2, name: "Euro"},
+	"FIM": {numericCode: "246", minorUnits: UnknownMinorUnits, name: "Markka", withdrawalDate: "2002-03"},
+	"FJD": {numericCode: "242", minorUnits: 2, name: "Fiji Dollar"},
+	"FKP": {numericCode: "238", minorUnits: 2, name: "Falkland Islands Pound"},
+	"FRF": {numericCode: "250", minorUnits: UnknownMinorUnits, name: "French Franc", withdrawalDate: "2002-03"},
+	"GBP": {numericCode: "826", minorUnits: 2, name: "Pound Sterling"},
+	"GEK": {numericCode: "268", minorUnits: UnknownMinorUnits, name: "Georgian Coupon", withdrawalDate: "1995-10"},
+	"GEL": {numericCode: "981", minorUnits: 2, name: "Lari"},
+	"GHC": {numericCode: "288", minorUnits: UnknownMinorUnits, name: "Cedi", withdrawalDate: "2008-01"},
+	"GHP": {numericCode: "939", minorUnits: UnknownMinorUnits, name: "Ghana Cedi", withdrawalDate: "2007-06"},
+	"GHS": {numericCode: "936", minorUnits: 2, name: "Ghana Cedi"},
+	"GIP": {numericCode: "292", minorUnits: 2, name: "Gibraltar Pound"},
+	"GMD": {numericCode: "270", minorUnits: 2, name: "Dalasi"},
+	"GNE": {numericCode: "324", minorUnits: UnknownMinorUnits, name: "Syli", withdrawalDate: "1989-12"},
+	"GNF": {numericCode: "324", minorUnits: 0, name: "Guinean Franc"},
+	"GNS": {numericCode: "324", minorUnits: UnknownMinorUnits, name: "Syli", withdrawalDate: "1986-02"},
+	"GQE": {numericCode: "226", minorUnits: UnknownMinorUnits, name: "Ekwele", withdrawalDate: "1986-06"},
+	"GRD": {numericCode: "300", minorUnits: UnknownMinorUnits, name: "Drachma", withdrawalDate: "2002-03"},
+	"GTQ": {numericCode: "320", minorUnits: 2, name: "Quetzal"},
+	"GWE": {numericCode: "624", minorUnits: UnknownMinorUnits, name: "Guinea Escudo", withdrawalDate: "1981-12"},
+	"GWP": {numericCode: "624", minorUnits: UnknownMinorUnits, name: "Guinea-Bissau Peso", withdrawalDate: "1997-05"},
+	"GYD": {numericCode: "328", minorUnits: 2, name: "Guyana Dollar"},
+	"HKD": {numericCode: "344", minorUnits: 2, name: "Hong Kong Dollar"},
+	"HNL": {numericCode: "340", minorUnits: 2, name: "Lempira"},
+	"HRD": {numericCode: "191", minorUnits: UnknownMinorUnits, name: "Croatian Dinar", withdrawalDate: "1995-01"},
+	"HRK": {numericCode: "191", minorUnits: UnknownMinorUnits, name: "Kuna", withdrawalDate: "2023-01"},
+	"HTG": {numericCode: "332", minorUnits: 2, name: "Gourde"},
+	"HUF": {numericCode: "348", minorUnits: 2, name: "Forint"},
+	"IDR": {numericCode: "360", minorUnits: 2, name: "Rupiah"},
+	"IEP": {numericCode: "372", minorUnits: UnknownMinorUnits, name: "Irish Pound", withdrawalDate: "2002-03"},
+	"ILP": {numericCode: "376", minorUnits: UnknownMinorUnits, name: "Pound", withdrawalDate: "1981-12"},
+	"ILR": {numericCode: "376", minorUnits: UnknownMinorUnits, name: "Old Shekel", withdrawalDate: "1990-12"},
+	"ILS": {numericCode: "376", minorUnits: 2, name: "New Israeli Sheqel"},
+	"INR": {numericCode: "356", minorUnits: 2, name: "Indian Rupee"},
+	"IQD": {numericCode: "368", minorUnits: 3, name: "Iraqi Dinar"},
+	"IRR": {numericCode: "364", minorUnits: 2, name: "Iranian Rial"},
+	"ISJ": {numericCode: "352", minorUnits: UnknownMinorUnits, name: "Old Krona", withdrawalDate: "1990-12"},
+	"ISK": {numericCode: "352", minorUnits: 0, name: "Iceland Krona"},
+	"ITL": {numericCode: "380", minorUnits: UnknownMinorUnits, name: "Italian Lira", withdrawalDate: "2002-03"},
+	"JMD": {numericCode: "388", minorUnits: 2, name: "Jamaican Dollar"},
+	"JOD": {numericCode: "400", minorUnits: 3, name: "Jordanian Dinar"},
+	"JPY": {numericCode: "392", minorUnits: 0, name: "Yen"},
+	"KES": {numericCode: "404", minorUnits: 2, name: "Kenyan Shilling"},
+	"KGS": {numericCode: "417", minorUnits: 2, name: "Som"},
+	"KHR": {numericCode: "116", minorUnits: 2, name: "Riel"},
+	"KMF": {numericCode: "174", minorUnits: 0, name: "Comorian Franc"},
+	"KPW": {numericCode: "408", minorUnits: 2, name: "North Korean Won"},
+	"KRW": {numericCode: "410", minorUnits: 0, name: "Won"},
+	"KWD": {numericCode: "414", minorUnits: 3, name: "Kuwaiti Dinar"},
+	"KYD": {numericCode: "136", minorUnits: 2, name: "Cayman Islands Dollar"},
+	"KZT": {numericCode: "398", minorUnits: 2, name: "Tenge"},
+	"LAJ": {numericCode: "418", minorUnits: UnknownMinorUnits, name: "Pathet Lao Kip", withdrawalDate: "1979-12"},
+	"LAK": {numericCode: "418", minorUnits: 2, name: "Lao Kip"},
+	"LBP": {numericCode: "422", minorUnits: 2, name: "Lebanese Pound"},
+	"LKR": {numericCode: "144", minorUnits: 2, name: "Sri Lanka Rupee"},
+	"LRD": {numericCode: "430", minorUnits: 2, name: "Liberian Dollar"},
+	"LSL": {numericCode: "426", minorUnits: 2, name: "Loti"},
+	"LSM": {numericCode: "426", minorUnits: UnknownMinorUnits, name: "Loti", withdrawalDate: "1985-05"},
+	"LTL": {numericCode: "440", minorUnits: UnknownMinorUnits, name: "Lithuanian Litas", withdrawalDate: "2015-01"},
+	"LTT": {numericCode: "440", minorUnits: UnknownMinorUnits, name: "Talonas", withdrawalDate: "1993-07"},
+	"LUC": {numericCode: "989", minorUnits: UnknownMinorUnits, name: "Luxembourg Convertible Franc", withdrawalDate: "1990-03"},
+	"LUF": {numericCode: "442", minorUnits: UnknownMinorUnits, name: "Luxembourg Franc", withdrawalDate: "2002-03"},
+	"LUL": {numericCode: "988", minorUnits: UnknownMinorUnits, name: "Luxembourg Financial Franc", withdrawalDate: "1990-03"},
+	"LVL": {numericCode: "428", minorUnits: UnknownMinorUnits, name: "Latvian Lats", withdrawalDate: "2014-01"},
+	"LVR": {numericCode: "428", minorUnits: UnknownMinorUnits, name: "Latvian Ruble", withdrawalDate: "1994-12"},
+	"LYD": {numericCode: "434", minorUnits: 3, name: "Libyan Dinar"},
+	"MAD": {numericCode: "504", minorUnits: 2, name: "Moroccan Dirham"},
+	"MDL": {numericCode: "498", minorUnits: 2, name: "Moldovan Leu"},
+	"MGA": {numericCode: "969", minorUnits: 2, name: "Malagasy Ariary"},
+	"MGF": {numericCode: "450", minorUnits: UnknownMinorUnits, name: "Malagasy Franc", withdrawalDate: "2004-12"},
+	"MKD": {numericCode: "807", minorUnits: 2, name: "Denar"},
+	"MLF": {numericCode: "466", minorUnits: UnknownMinorUnits, name: "Mali Franc", withdrawalDate: "1984-11"},
+	"MMK": {numericCode: "104", minorUnits: 2, name: "Kyat"},
+	"MNT": {numericCode: "496", minorUnits: 2, name: "Tugrik"},
+	"MOP": {numericCode: "446", minorUnits: 2, name: "Pataca"},
+	"MRO": {numericCode: "478", minorUnits: UnknownMinorUnits, name: "Ouguiya", withdrawalDate: "2017-12"},
+	"MRU": {numericCode: "929", minorUnits: 2, name: "Ouguiya"},
+	"MTL": {numericCode: "470", minorUnits: UnknownMinorUnits, name: "Maltese Lira", withdrawalDate: "2008-01"},
+	"MTP": {numericCode: "470", minorUnits: UnknownMinorUnits, name: "Maltese Pound", withdrawalDate: "1983-06"},
+	"MUR": {numericCode: "480", minorUnits: 2, name: "Mauritius Rupee"},
+	"MVQ": {numericCode: "462", minorUnits: UnknownMinorUnits, name: "Maldive Rupee", withdrawalDate: "1989-12"},
+	"MVR": {numericCode: "462", minorUnits: 2, name: "Rufiyaa"},
+	"MWK": {numericCode: "454", minorUnits: 2, name: "Malawi Kwacha"},
+	"MXN": {numericCode: "484", minorUnits: 2, name: "Mexican Peso"},
+	"MXP": {numericCode: "484", minorUnits: UnknownMinorUnits, name: "Mexican Peso", withdrawalDate: "1993-01"},
+	"MXV": {numericCode: "979", minorUnits: 2, name: "Mexican Unidad de Inversion (UDI)"},
+	"MYR": {numericCode: "458", minorUnits: 2, name: "Malaysian Ringgit"},
+	"MZE": {numericCode: "508", minorUnits: UnknownMinorUnits, name: "Mozambique Escudo", withdrawalDate: "1981-12"},
+	"MZM": {numericCode: "508", minorUnits: UnknownMinorUnits, name: "Mozambique Metical", withdrawalDate: "2006-06"},
+	"MZN": {numericCode: "943", minorUnits: 2, name: "Mozambique Metical"},
+	"NAD": {numericCode: "516", minorUnits: 2, name: "Namibia Dollar"},
+	"NGN": {numericCode: "566", minorUnits: 2, name: "Naira"},
+	"NIC": {numericCode: "558", minorUnits: UnknownMinorUnits, name: "Cordoba", withdrawalDate: "1990-10"},
+	"NIO": {numericCode: "558", minorUnits: 2, name: "Cordoba Oro"},
+	"NLG": {numericCode: "528", minorUnits: UnknownMinorUnits, name: "Netherlands Guilder", withdrawalDate: "2002-03"},
+	"NOK": {numericCode: "578", minorUnits: 2, name: "Norwegian Krone"},
+	"NPR": {numericCode: "524", minorUnits: 2, name: "Nepalese Rupee"},
+	"NZD": {numericCode: "554", minorUnits: 2, name: "New Zealand Dollar"},
+	"OMR": {numericCode: "512", minorUnits: 3, name: "Rial Omani"},
+	"PAB": {numericCode: "590", minorUnits: 2, name: "Balboa"},
+	"PEH": {numericCode: "604", minorUnits: UnknownMinorUnits, name: "Sol", withdrawalDate: "1990-12"},
+	"PEI": {numericCode: "604", minorUnits: UnknownMinorUnits, name: "Inti", withdrawalDate: "1991-07"},
+	"PEN": {numericCode: "604", minorUnits: 2, name: "Sol"},
+	"PES": {numericCode: "604", minorUnits: UnknownMinorUnits, name: "Sol", withdrawalDate: "1986-02"},
+	"PGK": {numericCode: "598", minorUnits: 2, name: "Kina"},
+	"PHP": {numericCode: "608", minorUnits: 2, name: "Philippine Peso"},
+	"PKR": {numericCode: "586", minorUnits: 2, name: "Pakistan Rupee"},
+	"PLN": {numericCode: "985", minorUnits: 2, name: "Zloty"},
+	"PLZ": {numericCode: "616", minorUnits: UnknownMinorUnits, name: "Zloty", withdrawalDate: "1997-01"},
+	"PTE": {numericCode: "620", minorUnits: UnknownMinorUnits, name: "Portuguese Escudo", withdrawalDate: "2002-03"},
+	"PYG": {numericCode: "600", minorUnits: 0, name: "Guarani"},
+	"QAR": {numericCode: "634", minorUnits: 2, name: "Qatari Rial"},
+	"RHD": {numericCode: "716", minorUnits: UnknownMinorUnits, name: "Rhodesian Dollar", withdrawalDate: "1981-12"},
+	"ROK": {numericCode: "642", minorUnits: UnknownMinorUnits, name: "Leu A/52", withdrawalDate: "1990-12"},
+	"ROL": {numericCode: "642", minorUnits: UnknownMinorUnits, name: "Leu", withdrawalDate: "2005-06"},
+	"RON": {numericCode: "946", minorUnits: 2, name: "Romanian Leu"},
+	"RSD": {numericCode: "941", minorUnits: 2, name: "Serbian Dinar"},
+	"RUB": {numericCode: "643", minorUnits: 2, name: "Russian Ruble"},
+	"RUR": {numericCode: "810", minorUnits: UnknownMinorUnits, name: "Russian Ruble", withdrawalDate: "2004-01"},
+	"RWF": {numericCode: "646", minorUnits: 0, name: "Rwanda Franc"},
+	"SAR": {numericCode: "682", minorUnits: 2, name: "Saudi Riyal"},
+	"SBD": {numericCode: "090", minorUnits: 2, name: "Solomon Islands Dollar"},
+	"SCR": {numericCode: "690", minorUnits: 2, name: "Seychelles Rupee"},
+	"SDD": {numericCode: "736", minorUnits: UnknownMinorUnits, name: "Sudanese Dinar", withdrawalDate: "2007-07"},
+	"SDG": {numericCode: "938", minorUnits: 2, name: "Sudanese Pound"},
+	"SDP": {numericCode: "736", minorUnits: UnknownMinorUnits, name: "Sudanese Pound", withdrawalDate: "1998-06"},
+	"SEK": {numericCode: "752", minorUnits: 2, name: "Swedish Krona"},
+	"SGD": {numericCode: "702", minorUnits: 2, name: "Singapore Dollar"},
+	"SHP": {numericCode: "654", minorUnits: 2, name: "Saint Helena Pound"},
+	"SIT": {numericCode: "705", minorUnits: UnknownMinorUnits, name: "Tolar", withdrawalDate: "2007-01"},
+	"SKK": {numericCode: "703", minorUnits: UnknownMinorUnits, name: "Slovak Koruna", withdrawalDate: "2009-01"},
+	"SLE": {numericCode: "925", minorUnits: 2, name: "Leone"},
+	"SLL": {numericCode: "694", minorUnits: 2, name: "Leone"},
+	"SOS": {numericCode: "706", minorUnits: 2, name: "Somali Shilling"},
+	"SRD": {numericCode: "968", minorUnits: 2, name: "Surinam Dollar"},
+	"SRG": {numericCode: "740", minorUnits: UnknownMinorUnits, name: "Surinam Guilder", withdrawalDate: "2003-12"},
+	"SSP": {numericCode: "728", minorUnits: 2, name: "South Sudanese Pound"},
+	"STD": {numericCode: "678", minorUnits: UnknownMinorUnits, name: "Dobra", withdrawalDate: "2017-12"},
+	"STN": {numericCode: "930", minorUnits: 2, name: "Dobra"},
+	"SUR": {numericCode: "810", minorUnits: UnknownMinorUnits, name: "Rouble", withdrawalDate: "1990-12"},
+	"SVC": {numericCode: "222", minorUnits: 2, name: "El Salvador Colon"},
+	"SYP": {numericCode: "760", minorUnits: 2, name: "Syrian Pound"},
+	"SZL": {numericCode: "748", minorUnits: 2, name: "Lilangeni"},
+	"THB": {numericCode: "764", minorUnits: 2, name: "Baht"},
+	"TJR": {numericCode: "762", minorUnits: UnknownMinorUnits, name: "Tajik Ruble", withdrawalDate: "2001-04"},
+	"TJS": {numericCode: "972", minorUnits: 2, name: "Somoni"},
+	"TMM": {numericCode: "795", minorUnits: UnknownMinorUnits, name: "Turkmenistan Manat", withdrawalDate: "2009-01"},
+	"TMT": {numericCode: "934", minorUnits: 2, name: "Turkmenistan New Manat"},
+	"TND": {numericCode: "788", minorUnits: 3, name: "Tunisian Dinar"},
+	"TOP": {numericCode: "776", minorUnits: 2, name: "Pa'anga"},
+	"TPE": {numericCode: "626", minorUnits: UnknownMinorUnits, name: "Timor Escudo", withdrawalDate: "2002-11"},
+	"TRL": {numericCode: "792", minorUnits: UnknownMinorUnits, name: "Old Turkish Lira", withdrawalDate: "2005-12"},
+	"TRY": {numericCode: "949", minorUnits: 2, name: "Turkish Lira"},
+	"TTD": {numericCode: "780", minorUnits: 2, name: "Trinidad and Tobago Dollar"},
+	"TWD": {numericCode: "901", minorUnits: 2, name: "New Taiwan Dollar"},
+	"TZS": {numericCode: "834", minorUnits: 2, name: "Tanzanian Shilling"},
+	"UAH": {numericCode: "980", minorUnits: 2, name: "Hryvnia"},
+	"UAK": {numericCode: "804", minorUnits: UnknownMinorUnits, name: "Karbovanet", withdrawalDate: "1996-09"},
+	"UGS": {numericCode: "800", minorUnits: UnknownMinorUnits, name: "Uganda Shilling", withdrawalDate: "1987-05"},
+	"UGW": {numericCode: "800", minorUnits: UnknownMinorUnits, name: "Old Shilling", withdrawalDate: "1990-12"},
+	"UGX": {numericCode: "800", minorUnits: 0, name: "Uganda Shilling"},
+	"USD": {numericCode: "840", minorUnits: 2, name: "US Dollar"},
+	"USN": {numericCode: "997", minorUnits: 2, name: "US Dollar (Next day)"},
+	"USS": {numericCode: "998", minorUnits: UnknownMinorUnits, name: "US Dollar (Same day)", withdrawalDate: "2014-03"},
+	"UYI": {numericCode: "940", minorUnits: 0, name: "Uruguay Peso en Unidades Indexadas (UI)"},
+	"UYN": {numericCode: "858", minorUnits: UnknownMinorUnits, name: "Old Uruguay Peso", withdrawalDate: "1989-12"},
+	"UYP": {numericCode: "858", minorUnits: UnknownMinorUnits, name: "Uruguayan Peso", withdrawalDate: "1993-03"},
+	"UYU": {numericCode: "858", minorUnits: 2, name: "Peso Uruguayo"},
+	"UYW": {numericCode: "927", minorUnits: 4, name: "Unidad Previsional"},
+	"UZS": {numericCode: "860", minorUnits: 2, name: "Uzbekistan Sum"},
+	"VEB": {numericCode: "862", minorUnits: UnknownMinorUnits, name: "Bolivar", withdrawalDate: "2008-01"},
+	"VED": {numericCode: "926", minorUnits: 2, name: "Bolívar Soberano"},
+	"VEF": {numericCode: "937", minorUnits: UnknownMinorUnits, name: "Bolívar", withdrawalDate: "2018-08"},
+	"VES": {numericCode: "928", minorUnits: 2, name: "Bolívar Soberano"},
+	"VNC": {numericCode: "704", minorUnits: UnknownMinorUnits, name: "Old Dong", withdrawalDate: "1990-12"},
+	"VND": {numericCode: "704", minorUnits: 0, name: "Dong"},
+	"VUV": {numericCode: "548", minorUnits: 0, name: "Vatu"},
+	"WST": {numericCode: "882", minorUnits: 2, name: "Tala"},
+	"XAF": {numericCode: "950", minorUnits: 0, name: "CFA Franc BEAC"},
+	"XAG": {numericCode: "961", minorUnits: 0, name: "Silver"},
+	"XAU": {numericCode: "959", minorUnits: 0, name: "Gold"},
+	"XBA": {numericCode: "955", minorUnits: 0, name: "Bond Markets Unit European Composite Unit (EURCO)"},
+	"XBB": {numericCode: "956", minorUnits: 0, name: "Bond Markets Unit European Monetary Unit (E.M.U.-6)"},
+	"XBC": {numericCode: "957", minorUnits: 0, name: "Bond Markets Unit European Unit of Account 9 (E.U.A.-9)"},
+	"XBD": {numericCode: "958", minorUnits: 0, name: "Bond Markets Unit European Unit of Account 17 (E.U.A.-17)"},
+	"XCD": {numericCode: "951", minorUnits: 2, name: "East Caribbean Dollar"},
+	"XDR": {numericCode: "960", minorUnits: 0, name: "SDR (Special Drawing Right)"},
+	"XEU": {numericCode: "954", minorUnits: UnknownMinorUnits, name: "European Currency Unit (E.C.U)", withdrawalDate: "1999-01"},
+	"XOF": {numericCode: "952", minorUnits: 0, name: "CFA Franc BCEAO"},
+	"XPD": {numericCode: "964", minorUnits: 0, name: "Palladium"},
+	"XPF": {numericCode: "953", minorUnits: 0, name: "CFP Franc"},
+	"XPT": {numericCode: "962", minorUnits: 0, name: "Platinum"},
+	"XSU": {numericCode: "994", minorUnits: 0, name: "Sucre"},
+	"XTS": {numericCode: "963", minorUnits: 0, name: "Codes specifically reserved for testing purposes"},
+	"XUA": {numericCode: "965", minorUnits: 0, name: "ADB Unit of Account"},
+	"XXX": {numericCode: "999", minorUnits: 0, name: "The codes assigned for transactions where no currency is involved"},
+	"YDD": {numericCode: "720", minorUnits: UnknownMinorUnits, name: "Yemeni Dinar", withdrawalDate: "1991-09"},
+	"YER": {numericCode: "886", minorUnits: 2, name: "Yemeni Rial"},
+	"YUD": {numericCode: "890", minorUnits: UnknownMinorUnits, name: "New Yugoslavian Dinar", withdrawalDate: "1990-01"},
+	"YUM": {numericCode: "891", minorUnits: UnknownMinorUnits, name: "New Dinar", withdrawalDate: "2003-07"},
+	"YUN": {numericCode: "890", minorUnits: UnknownMinorUnits, name: "Yugoslavian Dinar", withdrawalDate: "1995-11"},
+	"ZAL": {numericCode: "991", minorUnits: UnknownMinorUnits, name: "Financial Rand", withdrawalDate: "1995-03"},
+	"ZAR": {numericCode: "710", minorUnits: 2, name: "Rand"},
+	"ZMK": {numericCode: "894", minorUnits: UnknownMinorUnits, name: "Zambian Kwacha", withdrawalDate: "2012-12"},
+	"ZMW": {numericCode: "967", minorUnits: 2, name: "Zambian Kwacha"},
+	"ZRN": {numericCode: "180", minorUnits: UnknownMinorUnits, name: "New Zaire", withdrawalDate: "1999-06"},
+	"ZRZ": {numericCode: "180", minorUnits: UnknownMinorUnits, name: "Zaire", withdrawalDate: "1994-02"},
+	"ZWC": {numericCode: "716", minorUnits: UnknownMinorUnits, name: "Rhodesian Dollar", withdrawalDate: "1989-12"},
+	"ZWD": {numericCode: "716", minorUnits: UnknownMinorUnits, name: "Zimbabwe Dollar", withdrawalDate: "2006-08"},
+	"ZWG": {numericCode: "924", minorUnits: 2, name: "Zimbabwe Gold"},
+	"ZWL": {numericCode: "932", minorUnits: UnknownMinorUnits, name: "Zimbabwe Dollar", withdrawalDate: "2024-09"},
+	"ZWN": {numericCode: "942", minorUnits: UnknownMinorUnits, name: "Zimbabwe Dollar (new)", withdrawalDate: "2006-08"},
+	"ZWR": {numericCode: "935", minorUnits: UnknownMinorUnits, name: "Zimbabwe Dollar", withdrawalDate: "2009-06"},
+}
@@ -0,0 +1,105 @@
+package money
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestISO4217(t *testing.T) {
+	alpha := regexp.MustCompile(`^[A-Z]{3}$`)
+	numeric := regexp.MustCompile(`^[0-9]{3}$`)
+	month := regexp.MustCompile(`^[0-9]{4}-[0-9]{2}$`)
+	current := map[string]string{}
+	for code, iso := range iso4217 {
+		assert.Regexp(t, alpha, code)
+		assert.Regexp(t, numeric, iso.numericCode, code)
+		assert.NotEmpty(t, iso.name, code)
+		if iso.withdrawalDate == "" {
+			assert.NotContains(t, current, iso.numericCode, code)
+			current[iso.numericCode] = code
+		} else {
+			assert.Regexp(t, month, iso.withdrawalDate, code)
+			assert.Equal(t, UnknownMinorUnits, iso.minorUnits, code)
+		}
+	}
+
+	for _, code := range []string{"ADP", "AFA", "BGL", "ECS", "MGF", "RUR", "SUR", "XEU", "YUM", "ZWD"} {
+		assert.NotEmpty(t, iso4217[code].withdrawalDate, code)
+	}
+}
+
+func TestCurrency_ISO4217(t *testing.T) {
+	testTable := []struct {
+		code           string
+		numericCode    string
+		name           string
+		minorUnits     int
+		fraction       int
+		withdrawalDate string
+	}{
+		{
+			code:        "USD",
+			numericCode: "840",
+			name:        "US Dollar",
+			minorUnits:  2,
+			fraction:    2,
+		},
+		{
+			code:        "TWD",
+			numericCode: "901",
+			name:        "New Taiwan Dollar",
+			minorUnits:  2,
+			fraction:    0,
+		},
+		{
+			code:        "BHD",
+			numericCode: "048",
+			name:        "Bahraini Dinar",
+			minorUnits:  3,
+			fraction:    3,
+		},
+		{
+			code:        "UYW",
+			numericCode: "927",
+			name:        "Unidad Previsional",
+			minorUnits:  4,
+			fraction:    4,
+		},
+		{
+			code:           "DEM",
+			numericCode:    "276",
+			name:           "Deutsche Mark",
+			minorUnits:     UnknownMinorUnits,
+			fraction:       2,
+			withdrawalDate: "2002-03",
+		},
+	}
+	for _, item := range testTable {
+		c := New(0, item.code).GetCurrency()
+		assert.Equal(t, item.numericCode, c.NumericCode(), item.code)
+		assert.Equal(t, item.name, c.Name(), item.code)
+		assert.Equal(t, item.minorUnits, c.MinorUnits(), item.code)
+		assert.Equal(t, item.fraction, c.Fraction, item.code)
+		assert.Equal(t, item.withdrawalDate, c.WithdrawalDate(), item.code)
+	}
+}
+
+func TestRegistry_LookupNumeric(t *testing.T) {
+	r := NewRegistry()
+	assert.NoError(t, r.Register(NewCurrency("TWD", 0, WithGrapheme("NT$"))))
+
+	c, ok := r.LookupNumeric("901")
+	assert.True(t, ok)
+	assert.Equal(t, "NT$", c.Grapheme)
+
+	c, ok = r.LookupNumeric("978")
+	assert.True(t, ok)
+	assert.Equal(t, "EUR", c.Code)
+	_, ok = r.Lookup("EUR")
+	assert.True(t, ok)
+
+	_, ok = r.LookupNumeric("000")
+	assert.False(t, ok)
+}
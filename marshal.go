@@ -0,0 +1,114 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// moneyPayload is the serialized form of Money, pointers tell omitted fields from zero values
+type moneyPayload struct {
+	Cents          *int64   `json:"cents" bson:"cents"`
+	CurrencySymbol *string  `json:"currency_symbol" bson:"currency_symbol"`
+	CurrencyIso    *string  `json:"currency_iso" bson:"currency_iso"`
+	Label          *string  `json:"label" bson:"label"`
+	Dollars        *float64 `json:"dollars" bson:"dollars"`
+}
+
+// moneyDocument is the serialized form of Money with Label and Dollars filled
+type moneyDocument struct {
+	Cents          int64   `json:"cents" bson:"cents"`
+	CurrencySymbol string  `json:"currency_symbol" bson:"currency_symbol"`
+	CurrencyIso    string  `json:"currency_iso" bson:"currency_iso"`
+	Label          string  `json:"label" bson:"label"`
+	Dollars        float64 `json:"dollars" bson:"dollars"`
+}
+
+func (m Money) document() moneyDocument {
+	doc := moneyDocument{
+		Cents:          m.Cents,
+		CurrencySymbol: m.CurrencySymbol,
+		CurrencyIso:    m.CurrencyIso,
+	}
+	// The zero value of Money, e.g. an unset struct field, has no currency to format with
+	if m.CurrencyIso == "" {
+		return doc
+	}
+	f := m.format()
+	doc.Label = f.label
+	doc.Dollars = f.dollars
+	return doc
+}
+
+// MarshalJSON implements json.Marshaler. Label and Dollars are computed from Cents, so the payload is the same whether
+// m was created with New or computed.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.document())
+}
+
+// MarshalBSON implements bson.Marshaler, see MarshalJSON
+func (m Money) MarshalBSON() ([]byte, error) {
+	return bson.Marshal(m.document())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. See rehydrate for how the payload is validated.
+func (m *Money) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		return nil
+	}
+	var payload moneyPayload
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return err
+	}
+	return m.rehydrate(payload)
+}
+
+// UnmarshalBSON implements bson.Unmarshaler. See rehydrate for how the payload is validated.
+// Null, which the driver passes as no bytes, leaves m untouched like UnmarshalJSON does.
+func (m *Money) UnmarshalBSON(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	var payload moneyPayload
+	if err := bson.Unmarshal(b, &payload); err != nil {
+		return err
+	}
+	return m.rehydrate(payload)
+}
+
+// rehydrate rebuilds m from Cents and CurrencyIso with the default rounding mode and smallest denomination.
+// Dollars is derived state, so payloads whose Dollars contradict Cents are rejected. Label is rebuilt from the
+// currency as registered now rather than compared, as documents written before a template or grapheme change
+// have a different label.
+// An empty CurrencyIso with zero Cents is the zero value of Money, e.g. an unset struct field, and is kept as is.
+// Payloads come from untrusted input, so codes not registered yet are accepted like New does but not registered.
+func (m *Money) rehydrate(payload moneyPayload) error {
+	if payload.Cents == nil || payload.CurrencyIso == nil {
+		return ErrInvalidPayload
+	}
+	if *payload.CurrencyIso == "" {
+		if *payload.Cents != 0 {
+			return ErrInvalidCurrency
+		}
+		if payload.Dollars != nil && *payload.Dollars != 0 {
+			return ErrInconsistentPayload
+		}
+		*m = Money{}
+		return nil
+	}
+
+	// Upper-cased like go-money does for New
+	code := strings.ToUpper(*payload.CurrencyIso)
+	currency, ok := defaultRegistry.Lookup(code)
+	if !ok {
+		currency = fallbackCurrency(code)
+	}
+	nm := newFromCurrency(*payload.Cents, currency).fill()
+	if payload.Dollars != nil && *payload.Dollars != nm.Dollars {
+		return ErrInconsistentPayload
+	}
+	*m = *nm
+	return nil
+}
@@ -0,0 +1,195 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type order struct {
+	Total    Money  `json:"total" bson:"total"`
+	Discount *Money `json:"discount" bson:"discount"`
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	m := New(2855, "USD", WithRoundingMode(RoundUp))
+	b, err := json.Marshal(order{Total: *m, Discount: New(-100, "USD")})
+	assert.NoError(t, err)
+
+	var o order
+	assert.NoError(t, json.Unmarshal(b, &o))
+	assert.Equal(t, int64(2855), o.Total.Cents)
+	assert.Equal(t, 28.55, o.Total.Dollars)
+	assert.Equal(t, "US$28.55", o.Total.Label)
+	assert.Equal(t, "US$28.55", o.Total.Display())
+	assert.Equal(t, RoundBankers, o.Total.GetRoundingMode())
+	assert.Equal(t, int32(1), o.Total.GetSmallestDenomination())
+	assert.Equal(t, "-US$1.00", o.Discount.Display())
+
+	nm, err := o.Total.Add(o.Discount)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2755), nm.Cents)
+}
+
+func TestUnmarshalJSON_PartialPayload(t *testing.T) {
+	var m Money
+	assert.NoError(t, json.Unmarshal([]byte(`{"cents": 100000, "currency_iso": "TWD"}`), &m))
+	assert.Equal(t, "NT$100,000", m.Label)
+	assert.Equal(t, float64(100000), m.Dollars)
+	assert.Equal(t, "NT$", m.CurrencySymbol)
+
+	var o order
+	assert.NoError(t, json.Unmarshal([]byte(`{"total": {"cents": 1, "currency_iso": "TWD"}, "discount": null}`), &o))
+	assert.Nil(t, o.Discount)
+}
+
+func TestUnmarshalJSON_WithError(t *testing.T) {
+	testTable := []struct {
+		payload  string
+		expected error
+	}{
+		{
+			payload:  `{"currency_iso": "TWD"}`,
+			expected: ErrInvalidPayload,
+		},
+		{
+			payload:  `{"cents": 100}`,
+			expected: ErrInvalidPayload,
+		},
+		{
+			payload:  `{"cents": 100, "currency_iso": ""}`,
+			expected: ErrInvalidCurrency,
+		},
+		{
+			payload:  `{"cents": 0, "currency_iso": "", "dollars": 1}`,
+			expected: ErrInconsistentPayload,
+		},
+		{
+			payload:  `{"cents": 100, "currency_iso": "USD", "dollars": 100}`,
+			expected: ErrInconsistentPayload,
+		},
+	}
+	for _, item := range testTable {
+		var m Money
+		err := json.Unmarshal([]byte(item.payload), &m)
+		assert.ErrorIs(t, err, item.expected, item.payload)
+	}
+}
+
+func TestUnmarshal_ZeroValue(t *testing.T) {
+	var m Money
+	assert.NoError(t, json.Unmarshal([]byte(`{"cents": 0, "currency_symbol": "", "currency_iso": "", "label": "", "dollars": 0}`), &m))
+	assert.Equal(t, int64(0), m.Cents)
+	assert.Equal(t, "", m.CurrencyIso)
+
+	b, err := bson.Marshal(bson.M{"total": bson.M{"cents": int64(0), "currency_iso": "", "label": "", "dollars": 0.0}})
+	assert.NoError(t, err)
+	var o order
+	assert.NoError(t, bson.Unmarshal(b, &o))
+	assert.Equal(t, "", o.Total.CurrencyIso)
+}
+
+func TestMarshal_CentsChanged(t *testing.T) {
+	m := New(100, "USD")
+	m.Cents = 200
+	assert.Equal(t, "US$2.00", m.GetLabel())
+	assert.Equal(t, 2.0, m.GetDollars())
+
+	b, err := json.Marshal(m)
+	assert.NoError(t, err)
+	var nm Money
+	assert.NoError(t, json.Unmarshal(b, &nm))
+	assert.Equal(t, int64(200), nm.Cents)
+	assert.Equal(t, "US$2.00", nm.Label)
+
+	b, err = bson.Marshal(m)
+	assert.NoError(t, err)
+	assert.NoError(t, bson.Unmarshal(b, &nm))
+	assert.Equal(t, "US$2.00", nm.Label)
+}
+
+func TestMarshal_ZeroValue(t *testing.T) {
+	b, err := json.Marshal(order{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"total": {"cents": 0, "currency_symbol": "", "currency_iso": "", "label": "", "dollars": 0}, "discount": null}`, string(b))
+	var o order
+	assert.NoError(t, json.Unmarshal(b, &o))
+	assert.Equal(t, "", o.Total.CurrencyIso)
+
+	b, err = bson.Marshal(order{Discount: New(100, "USD")})
+	assert.NoError(t, err)
+	o = order{}
+	assert.NoError(t, bson.Unmarshal(b, &o))
+	assert.Equal(t, "", o.Total.CurrencyIso)
+	assert.Equal(t, int64(100), o.Discount.Cents)
+
+	// Marshaling never registers currencies
+	_, ok := DefaultRegistry().Lookup("")
+	assert.False(t, ok)
+	_, err = json.Marshal(Money{Cents: 100, CurrencyIso: "ZZX"})
+	assert.NoError(t, err)
+	_, ok = DefaultRegistry().Lookup("ZZX")
+	assert.False(t, ok)
+}
+
+func TestUnmarshalBSON_Null(t *testing.T) {
+	b, err := bson.Marshal(bson.M{"total": nil, "discount": nil})
+	assert.NoError(t, err)
+	var o order
+	assert.NoError(t, bson.Unmarshal(b, &o))
+	assert.Equal(t, "", o.Total.CurrencyIso)
+	assert.Nil(t, o.Discount)
+}
+
+func TestUnmarshal_UnregisteredCurrency(t *testing.T) {
+	// Money of any code New accepts round-trips, without the decoding registering the code
+	b, err := json.Marshal(New(100, "ZZW"))
+	assert.NoError(t, err)
+	DefaultRegistry().Unregister("ZZW")
+
+	var m Money
+	assert.NoError(t, json.Unmarshal(b, &m))
+	assert.Equal(t, "ZZW", m.CurrencyIso)
+	assert.Equal(t, int64(100), m.Cents)
+	assert.Equal(t, "1.00ZZW", m.Label)
+	_, ok := DefaultRegistry().Lookup("ZZW")
+	assert.False(t, ok)
+
+	b, err = bson.Marshal(bson.M{"cents": int64(100), "currency_iso": "ZZW"})
+	assert.NoError(t, err)
+	assert.NoError(t, bson.Unmarshal(b, &m))
+	assert.Equal(t, "ZZW", m.CurrencyIso)
+	_, ok = DefaultRegistry().Lookup("ZZW")
+	assert.False(t, ok)
+}
+
+func TestUnmarshal_LabelChanged(t *testing.T) {
+	// Documents written before a template or grapheme change are readable, the label follows the registry
+	var m Money
+	assert.NoError(t, json.Unmarshal([]byte(`{"cents": 100, "currency_iso": "USD", "label": "$1.00", "dollars": 1}`), &m))
+	assert.Equal(t, "US$1.00", m.Label)
+
+	b, err := bson.Marshal(bson.M{"cents": int32(100), "currency_iso": "USD", "label": "USD 1.00"})
+	assert.NoError(t, err)
+	assert.NoError(t, bson.Unmarshal(b, &m))
+	assert.Equal(t, "US$1.00", m.Label)
+}
+
+func TestUnmarshalBSON(t *testing.T) {
+	b, err := bson.Marshal(order{Total: *New(100000, "VND"), Discount: New(-500, "VND")})
+	assert.NoError(t, err)
+
+	var o order
+	assert.NoError(t, bson.Unmarshal(b, &o))
+	assert.Equal(t, int64(100000), o.Total.Cents)
+	assert.Equal(t, "100,000 ₫", o.Total.Display())
+	assert.Equal(t, int64(-500), o.Discount.Cents)
+	assert.Equal(t, "-500 ₫", o.Discount.Display())
+
+	b, err = bson.Marshal(bson.M{"cents": int32(100), "currency_iso": "USD", "dollars": 100.0})
+	assert.NoError(t, err)
+	var m Money
+	assert.ErrorIs(t, bson.Unmarshal(b, &m), ErrInconsistentPayload)
+}
@@ -2,24 +2,107 @@ package money
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"math/big"
+	"strings"
+	"sync/atomic"
 
 	gomoney "github.com/Rhymond/go-money"
 	"github.com/samber/lo"
 )
 
+type RoundingMode string
+
 const (
-	RoundUp      = "ROUND_UP"
-	RoundDown    = "ROUND_DOWN"
-	RoundHalfUp  = "ROUND_HALF_UP"
-	RoundBankers = "ROUND_BANKERS"
+	// RoundUp rounds toward positive infinity
+	RoundUp RoundingMode = "ROUND_UP"
+	// RoundDown rounds toward negative infinity
+	RoundDown RoundingMode = "ROUND_DOWN"
+	// RoundHalfUp rounds to the nearest neighbour, half is rounded away from zero like math.Round
+	RoundHalfUp RoundingMode = "ROUND_HALF_UP"
+	// RoundBankers rounds to the nearest neighbour, half is rounded to the even neighbour
+	RoundBankers RoundingMode = "ROUND_BANKERS"
+	// RoundHalfDown rounds to the nearest neighbour, half is rounded toward zero
+	RoundHalfDown RoundingMode = "ROUND_HALF_DOWN"
+	// RoundHalfOdd rounds to the nearest neighbour, half is rounded to the odd neighbour
+	RoundHalfOdd RoundingMode = "ROUND_HALF_ODD"
+	// RoundTowardZero drops the fraction, so refunds are rounded the same way as charges
+	RoundTowardZero RoundingMode = "ROUND_TOWARD_ZERO"
+	// RoundAwayFromZero rounds any fraction away from zero, so refunds are rounded the same way as charges
+	RoundAwayFromZero RoundingMode = "ROUND_AWAY_FROM_ZERO"
+	// RoundHalfAwayFromZero rounds to the nearest neighbour, half is rounded away from zero for negatives too.
+	// It behaves as RoundHalfUp, but is explicit about negatives.
+	RoundHalfAwayFromZero RoundingMode = "ROUND_HALF_AWAY_FROM_ZERO"
 )
 
+var roundingModes = []RoundingMode{
+	RoundUp,
+	RoundDown,
+	RoundHalfUp,
+	RoundBankers,
+	RoundHalfDown,
+	RoundHalfOdd,
+	RoundTowardZero,
+	RoundAwayFromZero,
+	RoundHalfAwayFromZero,
+}
+
+// ParseRoundingMode returns the RoundingMode named by mode, ignoring case and surrounding whitespace
+func ParseRoundingMode(mode string) (RoundingMode, error) {
+	rm := RoundingMode(strings.ToUpper(strings.TrimSpace(mode)))
+	if err := rm.Validate(); err != nil {
+		return "", err
+	}
+	return rm, nil
+}
+
+// Validate returns ErrInvalidRoundingMode if mode is not one of the declared modes.
+// Operations returning an error return ErrInvalidRoundingMode for an invalid mode set on Money,
+// the others fall back to RoundBankers.
+func (mode RoundingMode) Validate() error {
+	if !lo.Contains(roundingModes, mode) {
+		return fmt.Errorf("%w: %q", ErrInvalidRoundingMode, string(mode))
+	}
+	return nil
+}
+
+func (mode RoundingMode) String() string {
+	return string(mode)
+}
+
+// Errors returned by this package are sentinels, or wrap a sentinel, to be matched with errors.Is.
+// Structured errors like CurrencyMismatchError carry details to be retrieved with errors.As.
 var (
 	// Error
-	ErrorDivideByZero = errors.New("invalid operation: division by zero")
+	ErrDivideByZero = errors.New("invalid operation: division by zero")
+	// Deprecated: Use ErrDivideByZero, which is the same error.
+	ErrorDivideByZero      = ErrDivideByZero
+	ErrCurrencyMismatch    = gomoney.ErrCurrencyMismatch
+	ErrInvalidSplit        = errors.New("invalid operation: split must be higher than zero")
+	ErrInvalidRatios       = errors.New("invalid operation: ratios must be non-negative and sum higher than zero")
+	ErrInvalidDecimal      = errors.New("invalid operation: malformed decimal")
+	ErrOverflow            = errors.New("invalid operation: amount overflows int64 cents")
+	ErrInvalidFormat       = errors.New("invalid format: label is not a money amount")
+	ErrUnknownCurrency     = errors.New("unknown currency")
+	ErrAmbiguousCurrency   = errors.New("ambiguous currency: label matches several currencies")
+	ErrRateNotFound        = errors.New("exchange rate not found")
+	ErrInvalidRate         = errors.New("invalid exchange rate: rate must be higher than zero")
+	ErrInvalidCurrency     = errors.New("invalid currency: code must not be empty")
+	ErrInvalidDenomination = errors.New("invalid currency: smallest denomination must be higher than zero")
+	ErrInvalidFraction     = errors.New("invalid currency: fraction must not be negative")
+	ErrUnsupportedFormat   = errors.New("unsupported format: must be json or yaml")
+	ErrInvalidPayload      = errors.New("invalid payload: cents and currency_iso are required")
+	ErrInconsistentPayload = errors.New("invalid payload: dollars or label do not match cents")
+	ErrInvalidRoundingMode = errors.New("invalid rounding mode")
+	ErrNoMoney             = errors.New("invalid operation: at least one money is required")
+	ErrNilMoney            = errors.New("invalid operation: money is nil")
 )
 
+// Money is an amount in the minor units of a currency.
+//
+// A nil *Money is an absent amount, e.g. an optional price. Getters and predicates of a nil Money return zero values,
+// Multiply, Absolute and Negative return nil, and every other operation involving a nil Money returns ErrNilMoney.
 type Money struct {
 	Cents          int64   `json:"cents" bson:"cents"`
 	CurrencySymbol string  `json:"currency_symbol" bson:"currency_symbol"`
@@ -27,10 +110,22 @@ type Money struct {
 	Label          string  `json:"label" bson:"label"`
 	Dollars        float64 `json:"dollars" bson:"dollars"`
 
-	roundingMode         string
+	roundingMode         RoundingMode
+	symmetricRounding    bool
 	smallestDenomination int32
 	currency             *Currency
-	money                *gomoney.Money
+	registry             *Registry
+	// cache holds Label and Dollars computed on demand, the fields are left empty on intermediate results and go
+	// stale if Cents or CurrencyIso is changed afterwards, so they are read from cache, which follows both.
+	cache atomic.Value
+}
+
+// formatCache holds Label and Dollars of the cents and currency they were computed for
+type formatCache struct {
+	cents    int64
+	currency *Currency
+	label    string
+	dollars  float64
 }
 
 type DisplayOptions struct {
@@ -39,44 +134,86 @@ type DisplayOptions struct {
 
 type MoneyOption func(*Money)
 
-func WithRoundingMode(mode string) MoneyOption {
+func WithRoundingMode(mode RoundingMode) MoneyOption {
 	return func(m *Money) {
 		m.roundingMode = mode
 	}
 }
 
+// WithSymmetricRounding rounds the magnitude of negative amounts and re-applies the sign,
+// so a refund of -10.5 cents is rounded to -11 with RoundUp like a charge of 10.5 is rounded to 11
+func WithSymmetricRounding(symmetric bool) MoneyOption {
+	return func(m *Money) {
+		m.symmetricRounding = symmetric
+	}
+}
+
+// WithSmallestDenomination rounds to multiples of smallestDenomination cents, 0 uses the one of the currency.
+// Operations returning an error return ErrInvalidDenomination for a negative smallest denomination.
 func WithSmallestDenomination(smallestDenomination int32) MoneyOption {
 	return func(money *Money) {
 		money.smallestDenomination = smallestDenomination
 	}
 }
 
+// WithRegistry resolves the currency against registry instead of the default registry
+func WithRegistry(registry *Registry) MoneyOption {
+	return func(money *Money) {
+		money.registry = registry
+	}
+}
+
 type DisplayOption func(*DisplayOptions)
 
+// New creates Money with the Label and Dollars fields filled
 func New(cents int64, isoCode string, options ...MoneyOption) *Money {
 	nm := gomoney.New(cents, isoCode)
-	return newFromGoMoney(nm, options...)
+	return newFromGoMoney(nm, options...).fill()
 }
 
+// NewFromAmount creates Money from an amount in major units.
+//
+// Deprecated: Amounts that do not fit in int64 cents, and NaN, give meaningless cents without error.
+// Use NewFromAmountStrict or NewFromDecimal, which return ErrOverflow instead.
 func NewFromAmount(dollars float64, isoCode string, options ...MoneyOption) *Money {
-	currencyDecimals := math.Pow10(getCurrency(isoCode).Fraction)
+	// Temp money object without value
+	money := newFromGoMoney(gomoney.New(0, isoCode), options...)
+
+	currencyDecimals := math.Pow10(money.resolveCurrency().Fraction)
 	cents := dollars * currencyDecimals
+	nm := gomoney.New(int64(money.Round(cents)), isoCode)
+	return newFromGoMoney(nm, options...).fill()
+}
 
+func newFromAmount(dollars float64, isoCode string, options ...MoneyOption) (*Money, error) {
 	// Temp money object without value
 	money := newFromGoMoney(gomoney.New(0, isoCode), options...)
-	nm := gomoney.New(int64(money.Round(cents)), isoCode)
-	return newFromGoMoney(nm, options...)
+	if err := money.checkRoundingMode(); err != nil {
+		return nil, err
+	}
+	if err := money.checkSmallestDenomination(); err != nil {
+		return nil, err
+	}
+
+	currencyDecimals := math.Pow10(money.resolveCurrency().Fraction)
+	cents, err := floatToCents(money.Round(dollars * currencyDecimals))
+	if err != nil {
+		return nil, err
+	}
+	return newFromGoMoney(gomoney.New(cents, isoCode), options...).fill(), nil
 }
 
+// newFromGoMoney creates Money with the Label and Dollars fields left empty, see fill
 func newFromGoMoney(nm *gomoney.Money, options ...MoneyOption) *Money {
-	currency := getCurrency(nm.Currency().Code)
+	return newFromCurrency(nm.Amount(), registryFromOptions(options).resolve(nm.Currency().Code), options...)
+}
+
+// newFromCurrency is newFromGoMoney with the currency already resolved
+func newFromCurrency(cents int64, currency *Currency, options ...MoneyOption) *Money {
 	money := &Money{
-		money:                nm,
-		Cents:                nm.Amount(),
-		Dollars:              nm.AsMajorUnits(),
+		Cents:                cents,
 		CurrencyIso:          currency.Code,
 		CurrencySymbol:       currency.Grapheme,
-		Label:                nm.Display(),
 		roundingMode:         RoundBankers, // Default Round Mode will be RoundBankers
 		smallestDenomination: currency.smallestDenomination,
 		currency:             currency,
@@ -87,246 +224,678 @@ func newFromGoMoney(nm *gomoney.Money, options ...MoneyOption) *Money {
 	return money
 }
 
+// filled fills the Label and Dollars fields of the result of an operation returning an error
+func filled(m *Money, err error) (*Money, error) {
+	if err != nil {
+		return nil, err
+	}
+	return m.fill(), nil
+}
+
+// fill fills the Label and Dollars fields of Money returned to callers who may read them directly
+func (m *Money) fill() *Money {
+	f := m.format()
+	m.Label = f.label
+	m.Dollars = f.dollars
+	return m
+}
+
+// format returns Label and Dollars of m, computed on first use and cached.
+// The cache is safe for concurrent use and computed again if Cents or the currency was changed since.
+func (m *Money) format() formatCache {
+	currency := m.lookupCurrency()
+	if f, ok := m.cache.Load().(formatCache); ok && f.cents == m.Cents && f.currency == currency {
+		return f
+	}
+	formatter := currency.Formatter()
+	f := formatCache{
+		cents:    m.Cents,
+		currency: currency,
+		label:    formatter.Format(m.Cents),
+		dollars:  formatter.ToMajorUnits(m.Cents),
+	}
+	m.cache.Store(f)
+	return f
+}
+
+// lookupCurrency returns the currency of m like GetCurrency, without registering the code if m was not created
+// by this package, e.g. a struct literal being marshaled
+func (m *Money) lookupCurrency() *Currency {
+	if m.hasCurrency() {
+		return m.currency
+	}
+	if currency, ok := m.getRegistry().Lookup(m.CurrencyIso); ok {
+		return currency
+	}
+	return fallbackCurrency(m.CurrencyIso)
+}
+
+// registryFromOptions returns the registry set by WithRegistry in options, or the default registry
+func registryFromOptions(options []MoneyOption) *Registry {
+	money := &Money{}
+	for _, option := range options {
+		option(money)
+	}
+	return money.getRegistry()
+}
+
+func (m *Money) getRegistry() *Registry {
+	if m.registry == nil {
+		return defaultRegistry
+	}
+	return m.registry
+}
+
+// withCents creates Money of the same currency, registry and rounding settings as m.
+// It is the hot path of arithmetic, so it neither resolves the currency again nor formats Label and Dollars,
+// results returned to callers are filled with fill.
+func (m *Money) withCents(cents int64) *Money {
+	currency := m.resolveCurrency()
+	return &Money{
+		Cents:                cents,
+		CurrencyIso:          m.CurrencyIso,
+		CurrencySymbol:       currency.Grapheme,
+		roundingMode:         m.roundingMode,
+		symmetricRounding:    m.symmetricRounding,
+		smallestDenomination: m.smallestDenomination,
+		currency:             currency,
+		registry:             m.registry,
+	}
+}
+
 // Setting the roundingMode of the money object
-func (m *Money) SetRoundingMode(mode string) {
+func (m *Money) SetRoundingMode(mode RoundingMode) {
+	if m == nil {
+		return
+	}
 	m.roundingMode = mode
 }
 
 // Getting the roundingMode of the money object
-func (m *Money) GetRoundingMode() string {
+func (m *Money) GetRoundingMode() RoundingMode {
+	if m == nil {
+		return ""
+	}
 	return m.roundingMode
 }
 
+func (m *Money) SetSymmetricRounding(symmetric bool) {
+	if m == nil {
+		return
+	}
+	m.symmetricRounding = symmetric
+}
+
+func (m *Money) IsSymmetricRounding() bool {
+	if m == nil {
+		return false
+	}
+	return m.symmetricRounding
+}
+
 func (m *Money) SetSmallestDenomination(smallestDenomination int32) {
+	if m == nil {
+		return
+	}
 	m.smallestDenomination = smallestDenomination
 }
 
 func (m *Money) GetSmallestDenomination() int32 {
-	return m.smallestDenomination
-}
-
-func (m *Money) initMoney() {
-	if m.money == nil {
-		m.money = gomoney.New(m.Cents, m.CurrencyIso)
+	if m == nil {
+		return 0
 	}
+	return m.smallestDenomination
 }
 
 // Getting the round mode among all Money. If om is not exist, will return the first non-nil round mode. Otherwise will return RoundBankers
 func alignRoundingMode(m *Money, ma []*Money) MoneyOption {
+	return WithRoundingMode(alignedRoundingMode(m, ma))
+}
+
+func alignedRoundingMode(m *Money, ma []*Money) RoundingMode {
 	if m.roundingMode != "" {
-		return WithRoundingMode(m.roundingMode)
+		return m.roundingMode
 	}
-	fm, isFound := lo.Find(ma, func(money *Money) bool {
-		return money.roundingMode != ""
-	})
-	if isFound {
-		return WithRoundingMode(fm.roundingMode)
+	for _, money := range ma {
+		if money.roundingMode != "" {
+			return money.roundingMode
+		}
 	}
-	return WithRoundingMode(RoundBankers)
+	return RoundBankers
 }
 
 func alignSmallestDenomination(m *Money, ma []*Money) MoneyOption {
+	return WithSmallestDenomination(alignedSmallestDenomination(m, ma))
+}
+
+func alignedSmallestDenomination(m *Money, ma []*Money) int32 {
 	if m.smallestDenomination != 0 {
-		return WithSmallestDenomination(m.smallestDenomination)
+		return m.smallestDenomination
 	}
-	fm, isFound := lo.Find(ma, func(money *Money) bool {
-		return money.smallestDenomination != 0
-	})
-	if isFound {
-		return WithSmallestDenomination(fm.smallestDenomination)
+	for _, money := range ma {
+		if money.smallestDenomination != 0 {
+			return money.smallestDenomination
+		}
 	}
-	return WithSmallestDenomination(m.GetCurrency().smallestDenomination)
+	return m.resolveCurrency().smallestDenomination
 }
 
-// Round money with rounding mode set
+// Round money with rounding mode set, a nil Money rounds with the default RoundBankers
 func (m *Money) Round(value float64) float64 {
+	if m == nil {
+		return roundCentsWithExplicitMode(value, RoundBankers)
+	}
 	smallestDenomination := float64(m.smallestDenomination)
 	if smallestDenomination == 0.0 {
-		smallestDenomination = float64(m.GetCurrency().smallestDenomination)
+		smallestDenomination = float64(m.resolveCurrency().smallestDenomination)
 	}
 	value = value / smallestDenomination
+	if m.symmetricRounding && value < 0 {
+		return -roundCentsWithExplicitMode(-value, m.roundingMode) * smallestDenomination
+	}
 	return roundCentsWithExplicitMode(value, m.roundingMode) * smallestDenomination
 }
 
-func roundCentsWithExplicitMode(cents float64, mode string) float64 {
+// RoundForCash returns the amount payable in cash, rounded to the cash denomination of the currency with the rounding mode set,
+// and the adjustment from m to the rounded amount for the rounding line on receipts.
+func (m *Money) RoundForCash() (*Money, *Money, error) {
+	if m == nil {
+		return nil, nil, ErrNilMoney
+	}
+	cash, err := m.roundRatToDenomination(new(big.Rat).SetInt64(m.Cents), int64(m.resolveCurrency().GetCashDenomination()))
+	if err != nil {
+		return nil, nil, err
+	}
+	adjustment := new(big.Int).Sub(big.NewInt(cash), big.NewInt(m.Cents))
+	if !adjustment.IsInt64() {
+		return nil, nil, ErrOverflow
+	}
+	return m.withCents(cash).fill(), m.withCents(adjustment.Int64()).fill(), nil
+}
+
+func roundCentsWithExplicitMode(cents float64, mode RoundingMode) float64 {
 	switch mode {
 	case RoundUp:
 		return math.Ceil(cents)
 	case RoundDown:
 		return math.Floor(cents)
-	case RoundHalfUp:
+	case RoundHalfUp, RoundHalfAwayFromZero:
 		return math.Round(cents)
 	case RoundBankers:
 		return math.RoundToEven(cents)
+	case RoundHalfDown:
+		return math.Copysign(math.Ceil(math.Abs(cents)-0.5), cents)
+	case RoundHalfOdd:
+		floor := math.Floor(cents)
+		switch diff := cents - floor; {
+		case diff < 0.5:
+			return floor
+		case diff > 0.5:
+			return floor + 1
+		case math.Mod(floor, 2) == 0:
+			return floor + 1
+		default:
+			return floor
+		}
+	case RoundTowardZero:
+		return math.Trunc(cents)
+	case RoundAwayFromZero:
+		return math.Copysign(math.Ceil(math.Abs(cents)), cents)
 	default:
 		return math.RoundToEven(cents)
 	}
 }
 
 func (m *Money) Display(overrides ...DisplayOption) string {
+	if m == nil {
+		return ""
+	}
 	opts := &DisplayOptions{
 		ShowZero: true,
 	}
 	for _, override := range overrides {
 		override(opts)
 	}
-	if m.money.IsZero() && !opts.ShowZero {
+	if m.Cents == 0 && !opts.ShowZero {
 		return ""
 	}
-	return m.money.Display()
+	return m.format().label
 }
 
 // Equals checks equality between two Money types.
 func (m *Money) Equals(om *Money) (bool, error) {
-	m.initMoney()
-	om.initMoney()
-	return m.money.Equals(om.money)
+	if err := checkNil(m, om); err != nil {
+		return false, err
+	}
+	if err := checkCurrency(m, om); err != nil {
+		return false, err
+	}
+	return m.Cents == om.Cents, nil
 }
 
 // GreaterThan checks whether the value of Money is greater than the other.
 func (m *Money) GreaterThan(om *Money) (bool, error) {
-	m.initMoney()
-	om.initMoney()
-	return m.money.GreaterThan(om.money)
+	if err := checkNil(m, om); err != nil {
+		return false, err
+	}
+	if err := checkCurrency(m, om); err != nil {
+		return false, err
+	}
+	return m.Cents > om.Cents, nil
 }
 
 // GreaterThanOrEqual checks whether the value of Money is greater or equal than the other.
 func (m *Money) GreaterThanOrEqual(om *Money) (bool, error) {
-	m.initMoney()
-	om.initMoney()
-	return m.money.GreaterThanOrEqual(om.money)
+	if err := checkNil(m, om); err != nil {
+		return false, err
+	}
+	if err := checkCurrency(m, om); err != nil {
+		return false, err
+	}
+	return m.Cents >= om.Cents, nil
 }
 
 // LessThan checks whether the value of Money is less than the other.
 func (m *Money) LessThan(om *Money) (bool, error) {
-	m.initMoney()
-	om.initMoney()
-	return m.money.LessThan(om.money)
+	if err := checkNil(m, om); err != nil {
+		return false, err
+	}
+	if err := checkCurrency(m, om); err != nil {
+		return false, err
+	}
+	return m.Cents < om.Cents, nil
 }
 
 // LessThanOrEqual checks whether the value of Money is less or equal than the other.
 func (m *Money) LessThanOrEqual(om *Money) (bool, error) {
-	m.initMoney()
-	om.initMoney()
-	return m.money.LessThanOrEqual(om.money)
+	if err := checkNil(m, om); err != nil {
+		return false, err
+	}
+	if err := checkCurrency(m, om); err != nil {
+		return false, err
+	}
+	return m.Cents <= om.Cents, nil
 }
 
 // IsZero returns boolean of whether the value of Money is equals to zero.
 func (m *Money) IsZero() bool {
-	m.initMoney()
-	return m.money.IsZero()
+	if m == nil {
+		return false
+	}
+	return m.Cents == 0
 }
 
 // IsPositive returns boolean of whether the value of Money is positive.
 func (m *Money) IsPositive() bool {
-	m.initMoney()
-	return m.money.IsPositive()
+	if m == nil {
+		return false
+	}
+	return m.Cents > 0
 }
 
 // IsNegative returns boolean of whether the value of Money is negative.
 func (m *Money) IsNegative() bool {
-	m.initMoney()
-	return m.money.IsNegative()
+	if m == nil {
+		return false
+	}
+	return m.Cents < 0
 }
 
+// IsNil returns boolean of whether the Money is absent.
 func (m *Money) IsNil() bool {
 	return m == nil
 }
 
 // Absolute returns new Money struct from given Money using absolute monetary value.
+//
+// Deprecated: The absolute value of math.MinInt64 cents wraps around to math.MinInt64 without error.
+// Use CheckedAbsolute, which returns ErrOverflow instead.
 func (m *Money) Absolute() *Money {
-	m.initMoney()
-	nm := m.money.Absolute()
-	return New(nm.Amount(), m.CurrencyIso, WithRoundingMode(m.roundingMode), WithSmallestDenomination(m.smallestDenomination))
+	if m == nil {
+		return nil
+	}
+	if m.Cents < 0 {
+		return m.withCents(-m.Cents).fill()
+	}
+	return m.withCents(m.Cents).fill()
+}
+
+// CheckedAbsolute is Absolute returning ErrOverflow when the cents are math.MinInt64
+func (m *Money) CheckedAbsolute() (*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+	if m.Cents == math.MinInt64 {
+		return nil, ErrOverflow
+	}
+	if m.Cents < 0 {
+		return m.withCents(-m.Cents).fill(), nil
+	}
+	return m.withCents(m.Cents).fill(), nil
 }
 
 // Negative returns new Money struct from given Money using negative monetary value.
+// Negative amounts are kept as they are, so it cannot overflow.
 func (m *Money) Negative() *Money {
-	m.initMoney()
-	nm := m.money.Negative()
-	return New(nm.Amount(), m.CurrencyIso, WithRoundingMode(m.roundingMode), WithSmallestDenomination(m.smallestDenomination))
+	if m == nil {
+		return nil
+	}
+	if m.Cents > 0 {
+		return m.withCents(-m.Cents).fill()
+	}
+	return m.withCents(m.Cents).fill()
 }
 
 // Add returns new Money struct with value representing sum of Self and Other Money.
 // For the logic of attribute "roundingMode", please refer to function alignRoundingMode
 // For the logic of attribute showZero, if will just following the setting of m
+// Symmetric rounding follows the setting of m as well
 func (m *Money) Add(oms ...*Money) (*Money, error) {
-	m.initMoney()
-	innerMoney := m.money
+	return filled(m.add(oms...))
+}
+
+// add is Add leaving Label and Dollars empty, for results that are not returned to callers
+func (m *Money) add(oms ...*Money) (*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+	if err := checkNil(oms...); err != nil {
+		return nil, err
+	}
+	if err := checkCurrency(m, oms...); err != nil {
+		return nil, err
+	}
+	cents := m.Cents
 	var err error
 	for _, om := range oms {
-		om.initMoney()
-		innerMoney, err = innerMoney.Add(om.money)
-		if err != nil {
+		if cents, err = checkedAdd(cents, om.Cents); err != nil {
 			return nil, err
 		}
 	}
-	return New(innerMoney.Amount(), m.CurrencyIso, alignRoundingMode(m, oms), alignSmallestDenomination(m, oms)), nil
+	nm := m.withCents(cents)
+	nm.roundingMode = alignedRoundingMode(m, oms)
+	nm.smallestDenomination = alignedSmallestDenomination(m, oms)
+	return nm, nil
 }
 
 // Subtract returns new Money struct with value representing difference of Self and Other Money.
 // For the logic of attribute "roundingMode", please refer to function alignRoundingMode
 // For the logic of attribute showZero, if will just following the setting of m
+// Symmetric rounding follows the setting of m as well
 func (m *Money) Subtract(oms ...*Money) (*Money, error) {
-	m.initMoney()
-	innerMoney := m.money
+	return filled(m.subtract(oms...))
+}
+
+// subtract is Subtract leaving Label and Dollars empty, for results that are not returned to callers
+func (m *Money) subtract(oms ...*Money) (*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+	if err := checkNil(oms...); err != nil {
+		return nil, err
+	}
+	if err := checkCurrency(m, oms...); err != nil {
+		return nil, err
+	}
+	cents := m.Cents
 	var err error
 	for _, om := range oms {
-		om.initMoney()
-		innerMoney, err = innerMoney.Subtract(om.money)
-		if err != nil {
+		if cents, err = checkedSubtract(cents, om.Cents); err != nil {
 			return nil, err
 		}
 	}
-	return New(innerMoney.Amount(), m.CurrencyIso, alignRoundingMode(m, oms), alignSmallestDenomination(m, oms)), nil
+	nm := m.withCents(cents)
+	nm.roundingMode = alignedRoundingMode(m, oms)
+	nm.smallestDenomination = alignedSmallestDenomination(m, oms)
+	return nm, nil
 }
 
 // Multiply returns new Money struct with value representing Self multiplied value by multiplier. And If no rounding mode is setted, banker rounding mode is used
+//
+// Deprecated: Products that do not fit in int64 cents, and a NaN multiplier, give meaningless cents without error.
+// Use CheckedMultiply, MultiplyDecimal or MultiplyRat, which return ErrOverflow instead.
 func (m *Money) Multiply(mul float64) *Money {
-	m.initMoney()
+	if m == nil {
+		return nil
+	}
+	newCents := float64(m.Cents) * mul
+	return m.withCents(int64(m.Round(newCents))).fill()
+}
 
-	cents := m.money.Amount()
-	newCents := float64(cents) * mul
-	round := m.Round(newCents)
-	return New(int64(round), m.CurrencyIso, WithRoundingMode(m.roundingMode), WithSmallestDenomination(m.smallestDenomination))
+// CheckedMultiply is Multiply returning ErrOverflow when the product does not fit in int64 or mul is NaN
+func (m *Money) CheckedMultiply(mul float64) (*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+	if err := m.checkRoundingMode(); err != nil {
+		return nil, err
+	}
+	if err := m.checkSmallestDenomination(); err != nil {
+		return nil, err
+	}
+	newCents := float64(m.Cents) * mul
+	round, err := floatToCents(m.Round(newCents))
+	if err != nil {
+		return nil, err
+	}
+	return m.withCents(round).fill(), nil
 }
 
 // Divide returns new Money struct with value representing Self divided value by dividsor. And If no rounding mode is setted, banker rounding mode is used
 func (m *Money) Divide(div float64) (*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
 	if div == 0 {
-		return nil, ErrorDivideByZero
+		return nil, ErrDivideByZero
+	}
+	if err := m.checkRoundingMode(); err != nil {
+		return nil, err
+	}
+	if err := m.checkSmallestDenomination(); err != nil {
+		return nil, err
+	}
+	newCents := float64(m.Cents) / div
+	round, err := floatToCents(m.Round(newCents))
+	if err != nil {
+		return nil, err
+	}
+	return m.withCents(round).fill(), nil
+}
+
+// checkRoundingMode returns ErrInvalidRoundingMode if the rounding mode set is invalid, no rounding mode is RoundBankers
+func (m *Money) checkRoundingMode() error {
+	if m.roundingMode == "" {
+		return nil
+	}
+	return m.roundingMode.Validate()
+}
+
+// checkSmallestDenomination returns ErrInvalidDenomination if the smallest denomination set is negative,
+// no smallest denomination is the one of the currency
+func (m *Money) checkSmallestDenomination() error {
+	if m.smallestDenomination < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidDenomination, m.smallestDenomination)
+	}
+	return nil
+}
+
+// checkNil returns ErrNilMoney if any of ms is nil
+func checkNil(ms ...*Money) error {
+	for _, m := range ms {
+		if m == nil {
+			return ErrNilMoney
+		}
+	}
+	return nil
+}
+
+// checkedAdd returns the sum of cents and oc, or ErrOverflow instead of wrapping around int64
+func checkedAdd(cents int64, oc int64) (int64, error) {
+	if (oc > 0 && cents > math.MaxInt64-oc) || (oc < 0 && cents < math.MinInt64-oc) {
+		return 0, ErrOverflow
+	}
+	return cents + oc, nil
+}
+
+// checkedSubtract returns the difference of cents and oc, or ErrOverflow instead of wrapping around int64
+func checkedSubtract(cents int64, oc int64) (int64, error) {
+	if (oc < 0 && cents > math.MaxInt64+oc) || (oc > 0 && cents < math.MinInt64+oc) {
+		return 0, ErrOverflow
+	}
+	return cents - oc, nil
+}
+
+// floatToCents converts rounded cents to int64, float64(math.MaxInt64) is 2^63 so it is already out of range
+func floatToCents(cents float64) (int64, error) {
+	if math.IsNaN(cents) || cents < math.MinInt64 || cents >= math.MaxInt64 {
+		return 0, ErrOverflow
+	}
+	return int64(cents), nil
+}
+
+// Split returns n Money structs whose cents sum back to the original value.
+// Cents are distributed in units of the smallest denomination and the leftover units go to the first parties,
+// any cents below the smallest denomination are given to the first party.
+func (m *Money) Split(n int) ([]*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+	if n <= 0 {
+		return nil, ErrInvalidSplit
+	}
+	if err := m.checkSmallestDenomination(); err != nil {
+		return nil, err
+	}
+	return m.distribute(func(units int64) []int64 {
+		parts := make([]int64, n)
+		for i := range parts {
+			parts[i] = units / int64(n)
+		}
+		return parts
+	}), nil
+}
+
+// Allocate returns Money structs split by the given ratios whose cents sum back to the original value.
+// Leftovers are distributed the same way as Split.
+func (m *Money) Allocate(ratios ...int) ([]*Money, error) {
+	if m == nil {
+		return nil, ErrNilMoney
+	}
+	sum := big.NewInt(0)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrInvalidRatios
+		}
+		sum.Add(sum, big.NewInt(int64(ratio)))
+	}
+	if sum.Sign() == 0 {
+		return nil, ErrInvalidRatios
+	}
+	if err := m.checkSmallestDenomination(); err != nil {
+		return nil, err
+	}
+	return m.distribute(func(units int64) []int64 {
+		// units * ratio may not fit in int64, so the share is computed with big.Int
+		parts := make([]int64, len(ratios))
+		for i, ratio := range ratios {
+			share := new(big.Int).Mul(big.NewInt(units), big.NewInt(int64(ratio)))
+			parts[i] = share.Quo(share, sum).Int64()
+		}
+		return parts
+	}), nil
+}
+
+// distribute shares the cents in units of the smallest denomination with fn,
+// then gives the leftover units round-robin to the first parties.
+func (m *Money) distribute(fn func(units int64) []int64) []*Money {
+	smallestDenomination := int64(m.smallestDenomination)
+	if smallestDenomination == 0 {
+		smallestDenomination = int64(m.resolveCurrency().smallestDenomination)
+	}
+	units := m.Cents / smallestDenomination
+	remainder := m.Cents % smallestDenomination
+
+	parts := fn(units)
+	leftover := units
+	for _, part := range parts {
+		leftover -= part
+	}
+	step := int64(1)
+	if leftover < 0 {
+		step = -1
+	}
+	for i := 0; leftover != 0; i++ {
+		parts[i%len(parts)] += step
+		leftover -= step
 	}
-	m.initMoney()
 
-	cents := m.money.Amount()
-	newCents := float64(cents) / div
-	round := m.Round(newCents)
-	return New(int64(round), m.CurrencyIso, WithRoundingMode(m.roundingMode), WithSmallestDenomination(m.smallestDenomination)), nil
+	ms := make([]*Money, len(parts))
+	for i, part := range parts {
+		cents := part * smallestDenomination
+		if i == 0 {
+			cents += remainder
+		}
+		ms[i] = m.withCents(cents).fill()
+	}
+	return ms
 }
 
+// GetCurrency returns the currency of m, nil for Money without currency such as Money{}
 func (m *Money) GetCurrency() *Currency {
-	if m.currency == nil {
-		return getCurrency(m.CurrencyIso)
+	if m == nil || m.CurrencyIso == "" {
+		return nil
 	}
-	return m.currency
+	return m.resolveCurrency()
+}
+
+// resolveCurrency is GetCurrency for the arithmetic, which also needs the fraction of Money without currency
+func (m *Money) resolveCurrency() *Currency {
+	if m.hasCurrency() {
+		return m.currency
+	}
+	return m.getRegistry().resolve(m.CurrencyIso)
+}
+
+// hasCurrency returns true if m holds the currency of CurrencyIso, i.e. it was created by this package
+// and CurrencyIso was not changed since
+func (m *Money) hasCurrency() bool {
+	return m.currency != nil && m.currency.Code == m.CurrencyIso
 }
 
 func (m *Money) GetCents() int64 {
+	if m == nil {
+		return 0
+	}
 	return m.Cents
 }
 
 func (m *Money) GetCurrencySymbol() string {
+	if m == nil {
+		return ""
+	}
 	return m.CurrencySymbol
 }
 
 func (m *Money) GetCurrencyIso() string {
+	if m == nil {
+		return ""
+	}
 	return m.CurrencyIso
 }
 
+// GetLabel returns the amount formatted with its currency
 func (m *Money) GetLabel() string {
-	return m.Label
+	if m == nil {
+		return ""
+	}
+	return m.format().label
 }
 
+// GetDollars returns the amount in major units
 func (m *Money) GetDollars() float64 {
-	return m.Dollars
+	if m == nil {
+		return 0
+	}
+	return m.format().dollars
 }
@@ -1,10 +1,16 @@
 package money
 
 import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func TestNew(t *testing.T) {
@@ -65,37 +71,50 @@ func TestGetSmallestDenomination(t *testing.T) {
 	assert.Equal(t, int32(10), m.GetSmallestDenomination())
 }
 
-func TestInitMoney(t *testing.T) {
+func TestGetCurrency(t *testing.T) {
 	m := New(100, "TWD", WithRoundingMode(RoundUp))
-	m.initMoney()
-	assert.Equal(t, "TWD", m.money.Currency().Code)
+	assert.Equal(t, "TWD", m.GetCurrency().Code)
+
+	m = &Money{Cents: 100, CurrencyIso: "TWD"}
+	assert.Equal(t, "TWD", m.GetCurrency().Code)
+
+	// Money without currency has none, and using it never registers the empty code
+	m = &Money{}
+	assert.Nil(t, m.GetCurrency())
+	assert.Nil(t, New(0, "").GetCurrency())
+	sum, err := m.Add(&Money{Cents: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), sum.Cents)
+	assert.Equal(t, float64(1), sum.GetDollars())
+	_, ok := DefaultRegistry().Lookup("")
+	assert.False(t, ok)
 }
 
 func TestAlignRoundingMode(t *testing.T) {
 	testTable := []struct {
-		mainMoneyRoundingMode  string
-		paramMoneyRoundingMode []string
-		expected               string
+		mainMoneyRoundingMode  RoundingMode
+		paramMoneyRoundingMode []RoundingMode
+		expected               RoundingMode
 	}{
 		{
 			mainMoneyRoundingMode:  RoundUp,
-			paramMoneyRoundingMode: []string{RoundDown, RoundDown, RoundDown},
+			paramMoneyRoundingMode: []RoundingMode{RoundDown, RoundDown, RoundDown},
 			expected:               RoundUp,
 		},
 		{
 			mainMoneyRoundingMode:  "",
-			paramMoneyRoundingMode: []string{"", RoundDown, RoundUp},
+			paramMoneyRoundingMode: []RoundingMode{"", RoundDown, RoundUp},
 			expected:               RoundDown,
 		},
 		{
 			mainMoneyRoundingMode:  "",
-			paramMoneyRoundingMode: []string{"", "", ""},
+			paramMoneyRoundingMode: []RoundingMode{"", "", ""},
 			expected:               RoundBankers,
 		},
 	}
 	for _, item := range testTable {
 		m := New(1, "TWD", WithRoundingMode(item.mainMoneyRoundingMode))
-		ma := lo.Map(item.paramMoneyRoundingMode, func(roundMode string, _ int) *Money {
+		ma := lo.Map(item.paramMoneyRoundingMode, func(roundMode RoundingMode, _ int) *Money {
 			return New(1, "TWD", WithRoundingMode(roundMode))
 		})
 		result := New(1, "TWD", alignRoundingMode(m, ma))
@@ -106,7 +125,7 @@ func TestAlignRoundingMode(t *testing.T) {
 
 func TestRoundByModeAndSmallestDenomination(t *testing.T) {
 	testTable := []struct {
-		roundingMode         string
+		roundingMode         RoundingMode
 		smallestDenomination int32
 		inputValue           float64
 		expected             float64
@@ -382,7 +401,7 @@ func TestEqual_DifferentCurrencies(t *testing.T) {
 	nm, err := m1.Equals(m2)
 	assert.Error(t, err)
 	assert.Equal(t, false, nm)
-	assert.Equal(t, "currencies don't match", err.Error())
+	assert.Equal(t, "currencies don't match: TWD and USD", err.Error())
 }
 
 func TestGreaterThan_SameCurrencies(t *testing.T) {
@@ -423,7 +442,7 @@ func TestGreaterThan_DifferentCurrencies(t *testing.T) {
 	nm, err := m1.GreaterThan(m2)
 	assert.Error(t, err, err)
 	assert.Equal(t, false, nm)
-	assert.Equal(t, "currencies don't match", err.Error())
+	assert.Equal(t, "currencies don't match: TWD and USD", err.Error())
 }
 
 func TestGreaterThanOrEqual_SameCurrencies(t *testing.T) {
@@ -464,7 +483,7 @@ func TestGreaterThanOrEqual_DifferentCurrencies(t *testing.T) {
 	nm, err := m1.GreaterThanOrEqual(m2)
 	assert.Error(t, err, err)
 	assert.Equal(t, false, nm)
-	assert.Equal(t, "currencies don't match", err.Error())
+	assert.Equal(t, "currencies don't match: TWD and USD", err.Error())
 }
 
 func TestLessThan_SameCurrencies(t *testing.T) {
@@ -505,7 +524,7 @@ func TestLessThan_DifferentCurrencies(t *testing.T) {
 	nm, err := m1.LessThan(m2)
 	assert.Error(t, err, err)
 	assert.Equal(t, false, nm)
-	assert.Equal(t, "currencies don't match", err.Error())
+	assert.Equal(t, "currencies don't match: TWD and USD", err.Error())
 }
 
 func TestLessThanOrEqual_SameCurrencies(t *testing.T) {
@@ -546,7 +565,7 @@ func TestLessThanOrEqual_DifferentCurrencies(t *testing.T) {
 	nm, err := m1.LessThanOrEqual(m2)
 	assert.Error(t, err, err)
 	assert.Equal(t, false, nm)
-	assert.Equal(t, "currencies don't match", err.Error())
+	assert.Equal(t, "currencies don't match: TWD and USD", err.Error())
 }
 
 func TestIsZero(t *testing.T) {
@@ -760,7 +779,7 @@ func TestAdd_DifferentCurrencies(t *testing.T) {
 	nm, err := m1.Add(m2)
 	assert.Error(t, err)
 	assert.Nil(t, nm)
-	assert.Equal(t, "currencies don't match", err.Error())
+	assert.Equal(t, "currencies don't match: TWD and USD", err.Error())
 }
 
 func TestSubtract_SingleValue(t *testing.T) {
@@ -836,13 +855,13 @@ func TestSubtract_DifferentCurrencies(t *testing.T) {
 	nm, err := m1.Subtract(m2)
 	assert.Error(t, err)
 	assert.Nil(t, nm)
-	assert.Equal(t, "currencies don't match", err.Error())
+	assert.Equal(t, "currencies don't match: TWD and USD", err.Error())
 }
 
 func TestMultiply(t *testing.T) {
 	testTable := []struct {
 		multiplier float64
-		roundMode  string
+		roundMode  RoundingMode
 		expected   int64
 	}{
 		{
@@ -1036,7 +1055,7 @@ func TestMultiply(t *testing.T) {
 func TestDivide_NoError(t *testing.T) {
 	testTable := []struct {
 		dividend  float64
-		roundMode string
+		roundMode RoundingMode
 		expected  int64
 	}{
 		{
@@ -1054,11 +1073,6 @@ func TestDivide_NoError(t *testing.T) {
 			roundMode: RoundBankers,
 			expected:  0,
 		},
-		{
-			dividend:  4,
-			roundMode: "unknown",
-			expected:  0,
-		},
 		{
 			dividend:  5,
 			roundMode: RoundUp,
@@ -1074,11 +1088,6 @@ func TestDivide_NoError(t *testing.T) {
 			roundMode: RoundBankers,
 			expected:  0,
 		},
-		{
-			dividend:  5,
-			roundMode: "unknown",
-			expected:  0,
-		},
 		{
 			dividend:  6,
 			roundMode: RoundUp,
@@ -1094,11 +1103,6 @@ func TestDivide_NoError(t *testing.T) {
 			roundMode: RoundBankers,
 			expected:  1,
 		},
-		{
-			dividend:  6,
-			roundMode: "unknown",
-			expected:  1,
-		},
 	}
 	for _, item := range testTable {
 		m := New(int64(item.dividend), "HKD", WithRoundingMode(item.roundMode))
@@ -1113,4 +1117,850 @@ func TestDivide_WithError(t *testing.T) {
 	_, err := m.Divide(0)
 	assert.Error(t, err)
 	assert.ErrorIs(t, ErrorDivideByZero, err)
+
+	m = New(int64(5), "HKD", WithRoundingMode("unknown"))
+	_, err = m.Divide(10)
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+}
+
+func TestSplit(t *testing.T) {
+	testTable := []struct {
+		cents                int64
+		parties              int
+		smallestDenomination int32
+		expected             []int64
+	}{
+		{
+			cents:    100,
+			parties:  3,
+			expected: []int64{34, 33, 33},
+		},
+		{
+			cents:    -100,
+			parties:  3,
+			expected: []int64{-34, -33, -33},
+		},
+		{
+			cents:                100,
+			parties:              3,
+			smallestDenomination: 10,
+			expected:             []int64{40, 30, 30},
+		},
+		{
+			cents:                105,
+			parties:              2,
+			smallestDenomination: 10,
+			expected:             []int64{55, 50},
+		},
+		{
+			cents:    0,
+			parties:  2,
+			expected: []int64{0, 0},
+		},
+	}
+	for _, item := range testTable {
+		m := New(item.cents, "TWD", WithRoundingMode(RoundUp), WithSmallestDenomination(item.smallestDenomination))
+		ms, err := m.Split(item.parties)
+		assert.NoError(t, err)
+		cents := lo.Map(ms, func(m *Money, _ int) int64 { return m.Cents })
+		assert.Equal(t, item.expected, cents)
+		assert.Equal(t, item.cents, lo.Sum(cents))
+		for _, part := range ms {
+			assert.Equal(t, RoundUp, part.GetRoundingMode())
+			assert.Equal(t, item.smallestDenomination, part.GetSmallestDenomination())
+		}
+	}
+}
+
+func TestSplit_WithError(t *testing.T) {
+	m := New(100, "TWD")
+	_, err := m.Split(0)
+	assert.ErrorIs(t, err, ErrInvalidSplit)
+	_, err = m.Split(-1)
+	assert.ErrorIs(t, err, ErrInvalidSplit)
+}
+
+func TestAllocate(t *testing.T) {
+	testTable := []struct {
+		cents                int64
+		ratios               []int
+		smallestDenomination int32
+		expected             []int64
+	}{
+		{
+			cents:    100,
+			ratios:   []int{1, 1, 1},
+			expected: []int64{34, 33, 33},
+		},
+		{
+			cents:    100,
+			ratios:   []int{50, 25, 25},
+			expected: []int64{50, 25, 25},
+		},
+		{
+			cents:    5,
+			ratios:   []int{3, 7},
+			expected: []int64{2, 3},
+		},
+		{
+			cents:    -5,
+			ratios:   []int{3, 7},
+			expected: []int64{-2, -3},
+		},
+		{
+			cents:    100,
+			ratios:   []int{0, 1},
+			expected: []int64{0, 100},
+		},
+		{
+			cents:                1005,
+			ratios:               []int{1, 2},
+			smallestDenomination: 100,
+			expected:             []int64{405, 600},
+		},
+	}
+	for _, item := range testTable {
+		m := New(item.cents, "USD", WithSmallestDenomination(item.smallestDenomination))
+		ms, err := m.Allocate(item.ratios...)
+		assert.NoError(t, err)
+		cents := lo.Map(ms, func(m *Money, _ int) int64 { return m.Cents })
+		assert.Equal(t, item.expected, cents)
+		assert.Equal(t, item.cents, lo.Sum(cents))
+	}
+}
+
+func TestAllocate_WithError(t *testing.T) {
+	m := New(100, "TWD")
+	testTable := [][]int{
+		{},
+		{0, 0},
+		{1, -1},
+	}
+	for _, ratios := range testTable {
+		_, err := m.Allocate(ratios...)
+		assert.ErrorIs(t, err, ErrInvalidRatios)
+	}
+}
+
+func TestAdd_Overflow(t *testing.T) {
+	testTable := []struct {
+		cents1 int64
+		cents2 int64
+	}{
+		{
+			cents1: math.MaxInt64,
+			cents2: 1,
+		},
+		{
+			cents1: math.MinInt64,
+			cents2: -1,
+		},
+	}
+	for _, item := range testTable {
+		m1 := New(item.cents1, "VND")
+		m2 := New(item.cents2, "VND")
+		nm, err := m1.Add(m2)
+		assert.ErrorIs(t, err, ErrOverflow)
+		assert.Nil(t, nm)
+	}
+}
+
+func TestSubtract_Overflow(t *testing.T) {
+	testTable := []struct {
+		cents1 int64
+		cents2 int64
+	}{
+		{
+			cents1: math.MinInt64,
+			cents2: 1,
+		},
+		{
+			cents1: math.MaxInt64,
+			cents2: -1,
+		},
+		{
+			cents1: 0,
+			cents2: math.MinInt64,
+		},
+	}
+	for _, item := range testTable {
+		m1 := New(item.cents1, "VND")
+		m2 := New(item.cents2, "VND")
+		nm, err := m1.Subtract(m2)
+		assert.ErrorIs(t, err, ErrOverflow)
+		assert.Nil(t, nm)
+	}
+}
+
+func TestMultiply_Overflow(t *testing.T) {
+	m := New(math.MaxInt64/2, "VND")
+	// The deprecated Multiply keeps not failing
+	assert.NotPanics(t, func() { m.Multiply(3) })
+	assert.NotPanics(t, func() { m.Multiply(math.NaN()) })
+
+	_, err := m.CheckedMultiply(3)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = m.CheckedMultiply(math.NaN())
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	nm, err := New(150, "USD").CheckedMultiply(2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(300), nm.Cents)
+}
+
+func TestDivide_Overflow(t *testing.T) {
+	m := New(math.MaxInt64/2, "VND")
+	_, err := m.Divide(0.1)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestNegative_Overflow(t *testing.T) {
+	m := New(math.MinInt64, "VND")
+	// math.MinInt64 is negative already
+	assert.Equal(t, int64(math.MinInt64), m.Negative().Cents)
+	// The deprecated Absolute keeps not failing
+	assert.NotPanics(t, func() { m.Absolute() })
+
+	_, err := m.CheckedAbsolute()
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	nm, err := New(-150, "USD").CheckedAbsolute()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(150), nm.Cents)
+
+	var nilMoney *Money
+	_, err = nilMoney.CheckedAbsolute()
+	assert.ErrorIs(t, err, ErrNilMoney)
+}
+
+func TestNewFromAmount_Overflow(t *testing.T) {
+	// The deprecated NewFromAmount keeps not failing
+	assert.NotPanics(t, func() { NewFromAmount(1e19, "USD") })
+	assert.NotPanics(t, func() { NewFromAmount(math.NaN(), "USD") })
+
+	_, err := NewFromAmountStrict(1e19, "USD")
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, err = NewFromAmountStrict(math.NaN(), "USD")
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestAllocate_LargeAmount(t *testing.T) {
+	m := New(math.MaxInt64, "VND")
+	ms, err := m.Allocate(1000, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64/2+1), ms[0].Cents)
+	assert.Equal(t, int64(math.MaxInt64/2), ms[1].Cents)
+}
+
+func TestRoundingModes(t *testing.T) {
+	inputs := []string{"2.4", "2.5", "2.6", "3.5", "-2.4", "-2.5", "-2.6", "-3.5"}
+	testTable := []struct {
+		roundingMode RoundingMode
+		expected     []int64
+	}{
+		{
+			roundingMode: RoundUp,
+			expected:     []int64{3, 3, 3, 4, -2, -2, -2, -3},
+		},
+		{
+			roundingMode: RoundDown,
+			expected:     []int64{2, 2, 2, 3, -3, -3, -3, -4},
+		},
+		{
+			roundingMode: RoundHalfUp,
+			expected:     []int64{2, 3, 3, 4, -2, -3, -3, -4},
+		},
+		{
+			roundingMode: RoundBankers,
+			expected:     []int64{2, 2, 3, 4, -2, -2, -3, -4},
+		},
+		{
+			roundingMode: RoundHalfDown,
+			expected:     []int64{2, 2, 3, 3, -2, -2, -3, -3},
+		},
+		{
+			roundingMode: RoundHalfOdd,
+			expected:     []int64{2, 3, 3, 3, -2, -3, -3, -3},
+		},
+		{
+			roundingMode: RoundTowardZero,
+			expected:     []int64{2, 2, 2, 3, -2, -2, -2, -3},
+		},
+		{
+			roundingMode: RoundAwayFromZero,
+			expected:     []int64{3, 3, 3, 4, -3, -3, -3, -4},
+		},
+		{
+			roundingMode: RoundHalfAwayFromZero,
+			expected:     []int64{2, 3, 3, 4, -2, -3, -3, -4},
+		},
+	}
+	for _, item := range testTable {
+		m := New(0, "TWD", WithRoundingMode(item.roundingMode))
+		for i, input := range inputs {
+			value, err := strconv.ParseFloat(input, 64)
+			assert.NoError(t, err)
+			assert.Equal(t, float64(item.expected[i]), m.Round(value), "%s %s", item.roundingMode, input)
+
+			r, err := parseDecimal(input)
+			assert.NoError(t, err)
+			rounded, err := m.roundRat(r)
+			assert.NoError(t, err)
+			assert.Equal(t, item.expected[i], rounded, "%s %s", item.roundingMode, input)
+		}
+	}
+}
+
+func TestParseRoundingMode(t *testing.T) {
+	mode, err := ParseRoundingMode("ROUND_HALF_DOWN")
+	assert.NoError(t, err)
+	assert.Equal(t, RoundHalfDown, mode)
+
+	mode, err = ParseRoundingMode(" round_toward_zero ")
+	assert.NoError(t, err)
+	assert.Equal(t, RoundTowardZero, mode)
+
+	for _, input := range []string{"", "unknown", "ROUND_HALF"} {
+		_, err = ParseRoundingMode(input)
+		assert.ErrorIs(t, err, ErrInvalidRoundingMode, input)
+	}
+}
+
+func TestRoundingMode_Validate(t *testing.T) {
+	assert.NoError(t, RoundBankers.Validate())
+	assert.ErrorIs(t, RoundingMode("unknown").Validate(), ErrInvalidRoundingMode)
+	assert.ErrorIs(t, RoundingMode("").Validate(), ErrInvalidRoundingMode)
+}
+
+func TestInvalidSmallestDenomination(t *testing.T) {
+	invalid := WithSmallestDenomination(-5)
+	m := New(100, "USD", invalid)
+
+	_, err := m.MultiplyRat(big.NewRat(1, 3))
+	assert.ErrorIs(t, err, ErrInvalidDenomination)
+	_, err = m.DivideRat(big.NewRat(3, 1))
+	assert.ErrorIs(t, err, ErrInvalidDenomination)
+	_, err = m.Divide(3)
+	assert.ErrorIs(t, err, ErrInvalidDenomination)
+	_, err = m.CheckedMultiply(0.5)
+	assert.ErrorIs(t, err, ErrInvalidDenomination)
+	_, err = m.Split(3)
+	assert.ErrorIs(t, err, ErrInvalidDenomination)
+	_, err = m.Allocate(1, 2)
+	assert.ErrorIs(t, err, ErrInvalidDenomination)
+	_, err = NewFromDecimal("1.00", "USD", invalid)
+	assert.ErrorIs(t, err, ErrInvalidDenomination)
+	_, err = NewFromAmountStrict(1, "USD", invalid)
+	assert.ErrorIs(t, err, ErrInvalidDenomination)
+	_, err = NewConverter(StaticRates{}).Convert(m, "USD")
+	assert.ErrorIs(t, err, ErrInvalidDenomination)
+
+	// No smallest denomination set is the one of the currency
+	parts, err := New(100, "USD", WithSmallestDenomination(0)).Split(3)
+	assert.NoError(t, err)
+	assert.Len(t, parts, 3)
+}
+
+func TestInvalidRoundingMode(t *testing.T) {
+	invalid := WithRoundingMode("ROUND_HALF")
+	m := New(1005, "USD", invalid)
+
+	_, err := m.MultiplyRat(big.NewRat(1, 10))
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, err = m.DivideRat(big.NewRat(10, 1))
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, err = m.Divide(10)
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, err = m.CheckedMultiply(0.1)
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, _, err = m.RoundForCash()
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, err = NewFromDecimal("10.05", "USD", invalid)
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, err = NewFromRat(big.NewRat(201, 20), "USD", invalid)
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, err = NewFromAmountStrict(10.05, "USD", invalid)
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+
+	c := NewConverter(StaticRates{"USD": {"TWD": big.NewRat(32, 1)}})
+	_, err = c.Convert(m, "TWD")
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, err = c.Convert(m, "USD")
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+	_, err = NewConverter(StaticRates{}, invalid).Convert(New(1005, "USD"), "USD")
+	assert.ErrorIs(t, err, ErrInvalidRoundingMode)
+
+	// No rounding mode set is RoundBankers
+	nm, err := New(1005, "USD").MultiplyRat(big.NewRat(1, 10))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), nm.Cents)
+}
+
+func TestRoundByModeAndSmallestDenomination_SymmetricNegative(t *testing.T) {
+	testTable := []struct {
+		roundingMode         RoundingMode
+		smallestDenomination int32
+		inputValue           float64
+		expected             float64
+	}{
+		{
+			roundingMode:         RoundUp,
+			inputValue:           -104,
+			smallestDenomination: 10,
+			expected:             -110,
+		},
+		{
+			roundingMode:         RoundUp,
+			inputValue:           -105,
+			smallestDenomination: 10,
+			expected:             -110,
+		},
+		{
+			roundingMode:         RoundUp,
+			inputValue:           -106,
+			smallestDenomination: 10,
+			expected:             -110,
+		},
+		{
+			roundingMode:         RoundDown,
+			inputValue:           -104,
+			smallestDenomination: 10,
+			expected:             -100,
+		},
+		{
+			roundingMode:         RoundDown,
+			inputValue:           -105,
+			smallestDenomination: 10,
+			expected:             -100,
+		},
+		{
+			roundingMode:         RoundDown,
+			inputValue:           -106,
+			smallestDenomination: 10,
+			expected:             -100,
+		},
+		{
+			roundingMode:         RoundHalfUp,
+			inputValue:           -104,
+			smallestDenomination: 10,
+			expected:             -100,
+		},
+		{
+			roundingMode:         RoundHalfUp,
+			inputValue:           -105,
+			smallestDenomination: 10,
+			expected:             -110,
+		},
+		{
+			roundingMode:         RoundHalfUp,
+			inputValue:           -106,
+			smallestDenomination: 10,
+			expected:             -110,
+		},
+		{
+			roundingMode:         RoundBankers,
+			inputValue:           -104,
+			smallestDenomination: 10,
+			expected:             -100,
+		},
+		{
+			roundingMode:         RoundBankers,
+			inputValue:           -105,
+			smallestDenomination: 10,
+			expected:             -100,
+		},
+		{
+			roundingMode:         RoundBankers,
+			inputValue:           -115,
+			smallestDenomination: 10,
+			expected:             -120,
+		},
+		{
+			roundingMode:         RoundBankers,
+			inputValue:           -106,
+			smallestDenomination: 10,
+			expected:             -110,
+		},
+		{
+			roundingMode:         RoundUp,
+			inputValue:           -10.5,
+			smallestDenomination: 1,
+			expected:             -11,
+		},
+	}
+
+	for _, item := range testTable {
+		rd := New(0, "HKD", WithRoundingMode(item.roundingMode), WithSmallestDenomination(item.smallestDenomination), WithSymmetricRounding(true))
+		assert.Equal(t, item.expected, rd.Round(item.inputValue))
+		assert.Equal(t, -item.expected, rd.Round(-item.inputValue))
+
+		rounded, err := rd.roundRat(new(big.Rat).SetFloat64(item.inputValue))
+		assert.NoError(t, err)
+		assert.Equal(t, int64(item.expected), rounded)
+	}
+}
+
+func TestSymmetricRounding_Propagation(t *testing.T) {
+	m := New(-105, "HKD", WithRoundingMode(RoundUp), WithSymmetricRounding(true))
+	assert.True(t, m.IsSymmetricRounding())
+	assert.Equal(t, int64(-11), m.Multiply(0.1).Cents)
+
+	nm, err := m.Add(New(0, "HKD"))
+	assert.NoError(t, err)
+	assert.True(t, nm.IsSymmetricRounding())
+
+	nm, err = nm.DivideDecimal("10")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-11), nm.Cents)
+
+	m.SetSymmetricRounding(false)
+	assert.Equal(t, int64(-10), m.Multiply(0.1).Cents)
+}
+
+func TestRoundForCash(t *testing.T) {
+	testTable := []struct {
+		cents              int64
+		currency           string
+		roundingMode       RoundingMode
+		expected           int64
+		expectedAdjustment int64
+	}{
+		{
+			cents:              1234,
+			currency:           "CHF",
+			expected:           1235,
+			expectedAdjustment: 1,
+		},
+		{
+			cents:              1232,
+			currency:           "AUD",
+			expected:           1230,
+			expectedAdjustment: -2,
+		},
+		{
+			cents:              1237,
+			currency:           "AUD",
+			roundingMode:       RoundHalfUp,
+			expected:           1235,
+			expectedAdjustment: -2,
+		},
+		{
+			cents:              -1233,
+			currency:           "AUD",
+			roundingMode:       RoundHalfUp,
+			expected:           -1235,
+			expectedAdjustment: -2,
+		},
+		{
+			cents:              1255,
+			currency:           "NZD",
+			roundingMode:       RoundHalfUp,
+			expected:           1260,
+			expectedAdjustment: 5,
+		},
+		{
+			cents:              1250,
+			currency:           "SEK",
+			roundingMode:       RoundBankers,
+			expected:           1200,
+			expectedAdjustment: -50,
+		},
+		{
+			cents:              1234,
+			currency:           "USD",
+			expected:           1234,
+			expectedAdjustment: 0,
+		},
+	}
+	for _, item := range testTable {
+		m := New(item.cents, item.currency, WithRoundingMode(item.roundingMode))
+		cash, adjustment, err := m.RoundForCash()
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, cash.Cents, item.currency)
+		assert.Equal(t, item.expectedAdjustment, adjustment.Cents, item.currency)
+		assert.Equal(t, item.currency, adjustment.CurrencyIso)
+		total, err := m.Add(adjustment)
+		assert.NoError(t, err)
+		assert.Equal(t, cash.Cents, total.Cents)
+		// Electronic payments keep the smallest denomination
+		assert.Equal(t, int32(1), cash.GetSmallestDenomination())
+	}
+
+	_, _, err := New(math.MaxInt64, "CHF", WithRoundingMode(RoundUp)).RoundForCash()
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestNilMoney(t *testing.T) {
+	var m *Money
+	om := New(100, "USD")
+
+	// Getters and predicates treat nil as absent
+	assert.True(t, m.IsNil())
+	assert.Equal(t, int64(0), m.GetCents())
+	assert.Equal(t, "", m.GetCurrencyIso())
+	assert.Equal(t, "", m.GetCurrencySymbol())
+	assert.Equal(t, "", m.GetLabel())
+	assert.Equal(t, float64(0), m.GetDollars())
+	assert.Equal(t, RoundingMode(""), m.GetRoundingMode())
+	assert.Equal(t, int32(0), m.GetSmallestDenomination())
+	assert.False(t, m.IsSymmetricRounding())
+	assert.Nil(t, m.GetCurrency())
+	assert.False(t, m.IsZero())
+	assert.False(t, m.IsPositive())
+	assert.False(t, m.IsNegative())
+	assert.Equal(t, "", m.Display())
+	assert.Equal(t, float64(2), m.Round(2.5))
+	assert.NotPanics(t, func() {
+		m.SetRoundingMode(RoundUp)
+		m.SetSymmetricRounding(true)
+		m.SetSmallestDenomination(5)
+	})
+	assert.Nil(t, m.Multiply(2))
+	assert.Nil(t, m.Absolute())
+	assert.Nil(t, m.Negative())
+
+	// Operations return ErrNilMoney for a nil receiver or argument
+	for _, fn := range []func(a *Money, b *Money) (bool, error){
+		(*Money).Equals,
+		(*Money).GreaterThan,
+		(*Money).GreaterThanOrEqual,
+		(*Money).LessThan,
+		(*Money).LessThanOrEqual,
+	} {
+		_, err := fn(m, om)
+		assert.ErrorIs(t, err, ErrNilMoney)
+		_, err = fn(om, m)
+		assert.ErrorIs(t, err, ErrNilMoney)
+	}
+	for _, fn := range []func(a *Money, b ...*Money) (*Money, error){(*Money).Add, (*Money).Subtract} {
+		_, err := fn(m, om)
+		assert.ErrorIs(t, err, ErrNilMoney)
+		_, err = fn(om, om, m)
+		assert.ErrorIs(t, err, ErrNilMoney)
+	}
+	_, err := m.Divide(2)
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = m.MultiplyDecimal("2")
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = m.DivideRat(big.NewRat(2, 1))
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = m.Percent(MustPercentage("5"))
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = m.Split(2)
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = m.Allocate(1, 2)
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, _, err = m.RoundForCash()
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = NewConverter(StaticRates{}).Convert(m, "USD")
+	assert.ErrorIs(t, err, ErrNilMoney)
+	assert.ErrorIs(t, NewAccumulator("USD").Add(om, m), ErrNilMoney)
+	_, err = NewBag(om, m)
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = Sum(om, m)
+	assert.ErrorIs(t, err, ErrNilMoney)
+	_, err = Compare(om, m)
+	assert.ErrorIs(t, err, ErrNilMoney)
+	assert.ErrorIs(t, Sort([]*Money{om, m}), ErrNilMoney)
+}
+
+func TestArithmetic_Allocations(t *testing.T) {
+	m := New(2855, "USD", WithRoundingMode(RoundHalfUp))
+	om := New(145, "USD")
+	// Results returned to callers allocate the result Money and its Label
+	result := func(cents int64) float64 {
+		return testing.AllocsPerRun(100, func() { m.withCents(cents).fill() })
+	}
+	testTable := []struct {
+		name     string
+		fn       func()
+		expected float64
+	}{
+		{name: "Add", fn: func() { _, _ = m.Add(om) }, expected: result(3000)},
+		{name: "Subtract", fn: func() { _, _ = m.Subtract(om) }, expected: result(2710)},
+		{name: "Multiply", fn: func() { m.Multiply(1.05) }, expected: result(2998)},
+		{name: "Negative", fn: func() { m.Negative() }, expected: result(-2855)},
+		{name: "add", fn: func() { _, _ = m.add(om) }, expected: 1},
+		{name: "subtract", fn: func() { _, _ = m.subtract(om) }, expected: 1},
+		{name: "Equals", fn: func() { _, _ = m.Equals(om) }, expected: 0},
+		{name: "LessThan", fn: func() { _, _ = m.LessThan(om) }, expected: 0},
+		{name: "IsZero", fn: func() { m.IsZero() }, expected: 0},
+	}
+	for _, item := range testTable {
+		assert.Equal(t, item.expected, testing.AllocsPerRun(100, item.fn), item.name)
+	}
+}
+
+func TestArithmetic_Label(t *testing.T) {
+	m := New(2855, "USD")
+	nm, err := m.Add(New(145, "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, "US$30.00", nm.Label)
+	assert.Equal(t, float64(30), nm.Dollars)
+	assert.Equal(t, "US$30.00", nm.GetLabel())
+	assert.Equal(t, float64(30), nm.GetDollars())
+	assert.Equal(t, "US$30.00", nm.Display())
+	assert.Equal(t, "US$", nm.CurrencySymbol)
+
+	// Results of every operation returned to callers are filled
+	for _, result := range []*Money{nm, nm.Multiply(2), nm.Negative(), nm.Absolute()} {
+		assert.Equal(t, result.GetLabel(), result.Label)
+		assert.Equal(t, result.GetDollars(), result.Dollars)
+	}
+	parts, err := nm.Split(3)
+	assert.NoError(t, err)
+	sum, err := Sum(parts...)
+	assert.NoError(t, err)
+	b, err := NewBag(parts...)
+	assert.NoError(t, err)
+	product, err := nm.MultiplyRat(big.NewRat(1, 3))
+	assert.NoError(t, err)
+	for _, result := range append(parts, sum, product, b.List()[0], nm.ToAmount().ToMoney()) {
+		assert.NotEmpty(t, result.Label)
+		assert.Equal(t, result.GetLabel(), result.Label)
+		assert.Equal(t, result.GetDollars(), result.Dollars)
+	}
+
+	// Intermediate results are formatted on demand
+	im, err := m.add(New(145, "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, "", im.Label)
+	assert.Equal(t, "US$30.00", im.GetLabel())
+	assert.Equal(t, float64(30), im.GetDollars())
+
+	// Serialized results are the same as Money created with New
+	for _, result := range []*Money{nm, im} {
+		expected, err := json.Marshal(New(3000, "USD"))
+		assert.NoError(t, err)
+		b, err := json.Marshal(result)
+		assert.NoError(t, err)
+		assert.JSONEq(t, string(expected), string(b))
+		assert.JSONEq(t, `{"cents": 3000, "currency_symbol": "US$", "currency_iso": "USD", "label": "US$30.00", "dollars": 30}`, string(b))
+
+		expected, err = bson.Marshal(New(3000, "USD"))
+		assert.NoError(t, err)
+		b, err = bson.Marshal(result)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, b)
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	m := New(2855, "USD")
+	om := New(145, "USD")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Add(om)
+	}
+}
+
+func BenchmarkSubtract(b *testing.B) {
+	m := New(2855, "USD")
+	om := New(145, "USD")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Subtract(om)
+	}
+}
+
+func BenchmarkAmountAdd(b *testing.B) {
+	a := NewAmount(2855, "USD")
+	oa := NewAmount(145, "USD")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = a.Add(oa)
+	}
+}
+
+func BenchmarkMultiply(b *testing.B) {
+	m := New(2855, "USD")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		m.Multiply(1.05)
+	}
+}
+
+func BenchmarkMultiplyRat(b *testing.B) {
+	m := New(2855, "USD")
+	mul := big.NewRat(105, 100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.MultiplyRat(mul)
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	m := New(2855, "USD")
+	om := New(145, "USD")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.LessThan(om)
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		New(2855, "USD")
+	}
+}
+
+func TestLazyLabel_Cache(t *testing.T) {
+	nm, err := New(2855, "USD").add(New(145, "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, "US$30.00", nm.GetLabel())
+
+	// Formatted output is computed once, later reads are served from the cache
+	assert.Equal(t, float64(0), testing.AllocsPerRun(100, func() {
+		nm.GetLabel()
+		nm.GetDollars()
+	}))
+	assert.Equal(t, "", nm.Label)
+
+	// The cache follows changes of Cents
+	nm.Cents = 100
+	assert.Equal(t, "US$1.00", nm.GetLabel())
+	assert.Equal(t, float64(1), nm.GetDollars())
+
+	// and of the currency
+	nm.CurrencyIso = "TWD"
+	assert.Equal(t, "NT$100", nm.GetLabel())
+	assert.Equal(t, float64(100), nm.GetDollars())
+	assert.Equal(t, "NT$100", nm.Display())
+	assert.Equal(t, "TWD", nm.GetCurrency().Code)
+}
+
+func TestLazyLabel_StaleFields(t *testing.T) {
+	// Label and Dollars are derived from Cents and the currency, whatever the fields hold
+	m := &Money{Cents: 500, CurrencyIso: "USD", Label: "US$1.00", Dollars: 1}
+	assert.Equal(t, "US$5.00", m.GetLabel())
+	assert.Equal(t, float64(5), m.GetDollars())
+	assert.Equal(t, "US$5.00", m.Display())
+	b, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"label":"US$5.00"`)
+
+	m = New(100, "USD")
+	m.Cents = 500
+	assert.Equal(t, "US$1.00", m.Label)
+	assert.Equal(t, "US$5.00", m.GetLabel())
+	assert.Equal(t, float64(5), m.GetDollars())
+}
+
+func TestLazyLabel_Concurrency(t *testing.T) {
+	nm, err := New(2855, "USD").add(New(145, "USD"))
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, "US$30.00", nm.GetLabel())
+			b, err := json.Marshal(nm)
+			assert.NoError(t, err)
+			assert.Contains(t, string(b), `"label":"US$30.00"`)
+		}()
+	}
+	wg.Wait()
 }
@@ -0,0 +1,209 @@
+package money
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Parse creates Money from a label formatted like Display, e.g. "NT$1,234", "Rp 1.234,50" or "HK$-12.30",
+// using the grapheme and separators registered for isoCode in the registry set in options.
+// Fraction digits beyond the currency fraction are rounded with the rounding mode set in options.
+func Parse(label string, isoCode string, options ...MoneyOption) (*Money, error) {
+	currency := registryFromOptions(options).resolve(isoCode)
+
+	amount := label
+	if currency.Grapheme != "" && strings.Contains(amount, currency.Grapheme) {
+		amount = strings.Replace(amount, currency.Grapheme, "", 1)
+	} else {
+		amount = strings.Replace(amount, currency.Code, "", 1)
+	}
+	decimal, err := normalizeAmount(amount, currency)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromDecimal(decimal, currency.Code, options...)
+}
+
+// ParseAny creates Money from a label like Parse, the currency is identified by the marker written right before or
+// after the amount: a grapheme on the side its template puts it, e.g. "K1,000.00" for MMK and "1,000.00 K" for PGK,
+// or an ISO 4217 code on either side, e.g. "NOK 12.50". The candidates are the currencies registered in the registry
+// set in options and all ISO 4217 currencies, so the result does not depend on the currencies used so far.
+// ErrAmbiguousCurrency is returned when several currencies match the marker, e.g. "$5".
+func ParseAny(label string, options ...MoneyOption) (*Money, error) {
+	prefix, suffix, err := splitMarkers(label)
+	if err != nil {
+		return nil, err
+	}
+	var matches []*Currency
+	for _, currency := range parseCandidates(registryFromOptions(options)) {
+		if matchesMarker(currency, prefix, suffix) {
+			matches = append(matches, currency)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, ErrUnknownCurrency
+	case 1:
+		return Parse(label, matches[0].Code, options...)
+	default:
+		return nil, ErrAmbiguousCurrency
+	}
+}
+
+// splitMarkers returns the text before and after the digits of label, without whitespace and minus signs.
+// At most one of them may be set.
+func splitMarkers(label string) (string, string, error) {
+	isDigit := func(r rune) bool { return r >= '0' && r <= '9' }
+	first := strings.IndexFunc(label, isDigit)
+	if first < 0 {
+		return "", "", ErrInvalidFormat
+	}
+	last := strings.LastIndexFunc(label, isDigit)
+	trim := func(marker string) string {
+		return strings.TrimFunc(marker, func(r rune) bool {
+			return unicode.IsSpace(r) || r == '-' || r == '−'
+		})
+	}
+	prefix, suffix := trim(label[:first]), trim(label[last+1:])
+	if prefix != "" && suffix != "" {
+		return "", "", ErrInvalidFormat
+	}
+	return prefix, suffix, nil
+}
+
+// matchesMarker returns true if prefix or suffix is the code of currency, or its grapheme on the side of its template
+func matchesMarker(currency *Currency, prefix string, suffix string) bool {
+	marker := prefix + suffix
+	if marker == "" {
+		return false
+	}
+	if NormalizeCurrencyCode(marker) == currency.Code {
+		return true
+	}
+	if marker != currency.Grapheme {
+		return false
+	}
+	graphemeFirst := strings.Index(currency.Template, "$") < strings.Index(currency.Template, "1")
+	return graphemeFirst == (prefix != "")
+}
+
+var (
+	isoCandidatesOnce sync.Once
+	isoCandidates     []*Currency
+)
+
+// parseCandidates returns the currencies registered in registry and the ISO 4217 currencies not registered in it,
+// defined like resolve would register them
+func parseCandidates(registry *Registry) []*Currency {
+	isoCandidatesOnce.Do(func() {
+		for code := range iso4217 {
+			isoCandidates = append(isoCandidates, fallbackCurrency(code))
+		}
+	})
+	candidates := registry.List()
+	registered := make(map[string]bool, len(candidates))
+	for _, currency := range candidates {
+		registered[currency.Code] = true
+	}
+	for _, currency := range isoCandidates {
+		if !registered[currency.Code] {
+			candidates = append(candidates, currency)
+		}
+	}
+	return candidates
+}
+
+// normalizeAmount turns a localized amount without grapheme into a decimal string understood by NewFromDecimal.
+// The minus sign must precede the digits. Thousand separators, or whitespace in their place, must be used
+// consistently and split the integer digits into groups of three, so "12,34" is rejected instead of read as 1234.
+func normalizeAmount(amount string, currency *Currency) (string, error) {
+	amount = strings.TrimSpace(amount)
+	negative := false
+	if r, size := utf8.DecodeRuneInString(amount); r == '-' || r == '−' {
+		negative = true
+		amount = strings.TrimSpace(amount[size:])
+	}
+
+	integer, fraction := amount, ""
+	if currency.Decimal != "" {
+		if i := strings.Index(amount, currency.Decimal); i >= 0 {
+			integer, fraction = amount[:i], amount[i+len(currency.Decimal):]
+			if fraction == "" || !isDigits(fraction) {
+				return "", ErrInvalidFormat
+			}
+		}
+	}
+	integer, err := ungroupDigits(integer, currency.Thousand)
+	if err != nil {
+		return "", err
+	}
+	if integer == "" && fraction == "" {
+		return "", ErrInvalidFormat
+	}
+
+	decimal := integer
+	if decimal == "" {
+		decimal = "0"
+	}
+	if fraction != "" {
+		decimal += "." + fraction
+	}
+	if negative {
+		decimal = "-" + decimal
+	}
+	return decimal, nil
+}
+
+// ungroupDigits removes the separators from integer digits grouped by thousand, or by whitespace.
+// The first group has one to three digits and the others exactly three, all separated the same way.
+func ungroupDigits(integer string, thousand string) (string, error) {
+	var digits strings.Builder
+	groups := []int{0}
+	separator := ""
+	for len(integer) > 0 {
+		sep := ""
+		size := 0
+		r, runeSize := utf8.DecodeRuneInString(integer)
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+			groups[len(groups)-1]++
+			integer = integer[runeSize:]
+			continue
+		case thousand != "" && strings.HasPrefix(integer, thousand):
+			sep, size = thousand, len(thousand)
+		case unicode.IsSpace(r):
+			sep, size = " ", runeSize
+		default:
+			return "", ErrInvalidFormat
+		}
+		if (separator != "" && sep != separator) || groups[len(groups)-1] == 0 {
+			return "", ErrInvalidFormat
+		}
+		separator = sep
+		groups = append(groups, 0)
+		integer = integer[size:]
+	}
+	if len(groups) > 1 {
+		if groups[0] > 3 {
+			return "", ErrInvalidFormat
+		}
+		for _, group := range groups[1:] {
+			if group != 3 {
+				return "", ErrInvalidFormat
+			}
+		}
+	}
+	return digits.String(), nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,229 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	testTable := []struct {
+		label    string
+		currency string
+		expected int64
+	}{
+		{
+			label:    "NT$1,234",
+			currency: "TWD",
+			expected: 1234,
+		},
+		{
+			label:    "Rp 1.234,50",
+			currency: "IDR",
+			expected: 123450,
+		},
+		{
+			label:    "1 234 ₫",
+			currency: "VND",
+			expected: 1234,
+		},
+		{
+			label:    "HK$-12.30",
+			currency: "HKD",
+			expected: -1230,
+		},
+		{
+			label:    "-HK$12.30",
+			currency: "HKD",
+			expected: -1230,
+		},
+		{
+			label:    "1,000.00 ฿",
+			currency: "THB",
+			expected: 100000,
+		},
+		{
+			label:    "  US$0.5 ",
+			currency: "USD",
+			expected: 50,
+		},
+		{
+			label:    "USD 1,000",
+			currency: "USD",
+			expected: 100000,
+		},
+		{
+			label:    "円100,000",
+			currency: "JPY",
+			expected: 100000,
+		},
+		{
+			label:    "US$1,234,567.89",
+			currency: "USD",
+			expected: 123456789,
+		},
+		{
+			label:    "US$1234567.89",
+			currency: "USD",
+			expected: 123456789,
+		},
+		{
+			label:    "US$.5",
+			currency: "USD",
+			expected: 50,
+		},
+	}
+	for _, item := range testTable {
+		m, err := Parse(item.label, item.currency)
+		assert.NoError(t, err, item.label)
+		assert.Equal(t, item.expected, m.Cents, item.label)
+		assert.Equal(t, item.currency, m.CurrencyIso, item.label)
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	for _, currency := range DefaultRegistry().List() {
+		for _, cents := range []int64{0, 5, -123456789, 100000} {
+			m := New(cents, currency.Code)
+			nm, err := Parse(m.Display(), currency.Code)
+			assert.NoError(t, err, m.Display())
+			assert.Equal(t, cents, nm.Cents, m.Display())
+		}
+	}
+}
+
+func TestParse_WithRoundingMode(t *testing.T) {
+	m, err := Parse("NT$12.5", "TWD", WithRoundingMode(RoundUp))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(13), m.Cents)
+	assert.Equal(t, RoundUp, m.GetRoundingMode())
+}
+
+func TestParse_WithError(t *testing.T) {
+	testTable := []struct {
+		label    string
+		currency string
+	}{
+		{label: "", currency: "USD"},
+		{label: "US$", currency: "USD"},
+		{label: "US$12.3.4", currency: "USD"},
+		{label: "US$12.3 4", currency: "USD"},
+		{label: "US$1-2", currency: "USD"},
+		{label: "US$--12", currency: "USD"},
+		{label: "US$12abc", currency: "USD"},
+		{label: "NT$12,34.5,6", currency: "TWD"},
+		{label: "US$12,34", currency: "USD"},
+		{label: "US$1 2", currency: "USD"},
+		{label: "US$1,2,3.5", currency: "USD"},
+		{label: "US$1234,567", currency: "USD"},
+		{label: "US$1,234 567", currency: "USD"},
+		{label: "US$1,,234", currency: "USD"},
+		{label: "US$,123", currency: "USD"},
+		{label: "US$123,", currency: "USD"},
+		{label: "US$12.", currency: "USD"},
+		{label: "Rp 1.23,50", currency: "IDR"},
+	}
+	for _, item := range testTable {
+		_, err := Parse(item.label, item.currency)
+		assert.ErrorIs(t, err, ErrInvalidFormat, item.label)
+	}
+}
+
+func TestParseAny(t *testing.T) {
+	testTable := []struct {
+		label    string
+		expected string
+		cents    int64
+	}{
+		{
+			label:    "NT$1,234",
+			expected: "TWD",
+			cents:    1234,
+		},
+		{
+			label:    "HK$-12.30",
+			expected: "HKD",
+			cents:    -1230,
+		},
+		{
+			label:    "Rp 1.234,50",
+			expected: "IDR",
+			cents:    123450,
+		},
+		{
+			label:    "1 234 ₫",
+			expected: "VND",
+			cents:    1234,
+		},
+		{
+			label:    "K1,000.00",
+			expected: "MMK",
+			cents:    100000,
+		},
+		{
+			label:    "1,000.00 K",
+			expected: "PGK",
+			cents:    100000,
+		},
+		{
+			label:    "CAD 10.00",
+			expected: "CAD",
+			cents:    1000,
+		},
+		{
+			label:    "NOK 12.50",
+			expected: "NOK",
+			cents:    1250,
+		},
+		{
+			label:    "-HK$12.30",
+			expected: "HKD",
+			cents:    -1230,
+		},
+	}
+	for _, item := range testTable {
+		m, err := ParseAny(item.label)
+		assert.NoError(t, err, item.label)
+		assert.Equal(t, item.expected, m.CurrencyIso, item.label)
+		assert.Equal(t, item.cents, m.Cents, item.label)
+	}
+
+	_, err := ParseAny("12.30")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+	_, err = ParseAny("US$5 USD")
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+}
+
+func TestParseAny_Candidates(t *testing.T) {
+	registry := NewRegistry()
+	options := []MoneyOption{WithRegistry(registry)}
+
+	// "$" is the grapheme of many currencies, resolving one of them does not change the result
+	_, err := ParseAny("$5", options...)
+	assert.ErrorIs(t, err, ErrAmbiguousCurrency)
+	New(0, "ARS", options...)
+	_, err = ParseAny("$5", options...)
+	assert.ErrorIs(t, err, ErrAmbiguousCurrency)
+
+	// "K" of MMK in "NOK" is not a marker
+	_, ok := registry.Lookup("NOK")
+	assert.False(t, ok)
+	m, err := ParseAny("NOK 12.50", options...)
+	assert.NoError(t, err)
+	assert.Equal(t, "NOK", m.CurrencyIso)
+	assert.Equal(t, int64(1250), m.Cents)
+
+	// Codes not resolved yet are candidates
+	_, ok = registry.Lookup("CHF")
+	assert.False(t, ok)
+	m, err = ParseAny("CHF 10.00", options...)
+	assert.NoError(t, err)
+	assert.Equal(t, "CHF", m.CurrencyIso)
+	assert.Equal(t, int64(1000), m.Cents)
+
+	// Registered currencies replace the ISO 4217 definition
+	assert.NoError(t, registry.Register(NewCurrency("CHF", 2, WithGrapheme("Fr."), WithTemplate("$ 1"))))
+	m, err = ParseAny("Fr. 10.00", options...)
+	assert.NoError(t, err)
+	assert.Equal(t, "CHF", m.CurrencyIso)
+}
@@ -0,0 +1,88 @@
+package money
+
+import (
+	"math/big"
+	"strings"
+)
+
+const basisPointsPerUnit = 10000
+
+// Percentage is an exact rate such as a tax, commission or discount rate. The zero value is 0%.
+type Percentage struct {
+	// rate as a fraction of one, e.g. 0.0525 for 5.25%
+	rate *big.Rat
+}
+
+// NewPercentage creates Percentage from a decimal string in percent, e.g. "5.25" or "5.25%" for 5.25%
+func NewPercentage(percent string) (Percentage, error) {
+	r, err := parseDecimal(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(percent), "%")))
+	if err != nil {
+		return Percentage{}, err
+	}
+	return PercentageFromRat(r), nil
+}
+
+// MustPercentage is like NewPercentage but panics if percent is not a valid decimal, for rates known at compile time
+func MustPercentage(percent string) Percentage {
+	p, err := NewPercentage(percent)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// PercentageFromRat creates Percentage from an amount in percent, e.g. 21/4 for 5.25%
+func PercentageFromRat(percent *big.Rat) Percentage {
+	return Percentage{rate: new(big.Rat).Quo(percent, big.NewRat(100, 1))}
+}
+
+// PercentageFromBasisPoints creates Percentage from basis points, e.g. 525 for 5.25%
+func PercentageFromBasisPoints(bps int64) Percentage {
+	return Percentage{rate: big.NewRat(bps, basisPointsPerUnit)}
+}
+
+// Rat returns the rate as a fraction of one, e.g. 0.0525 for 5.25%
+func (p Percentage) Rat() *big.Rat {
+	if p.rate == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(p.rate)
+}
+
+// String returns the percentage as a decimal, e.g. "5.25%", or as a fraction when it has no finite decimal, e.g. "100/3%"
+func (p Percentage) String() string {
+	percent := new(big.Rat).Mul(p.Rat(), big.NewRat(100, 1))
+	if percent.IsInt() {
+		return percent.Num().String() + "%"
+	}
+	// A fraction has a finite decimal iff its reduced denominator only has the prime factors 2 and 5
+	denom := new(big.Int).Set(percent.Denom())
+	digits := 0
+	for _, factor := range []int64{2, 5} {
+		count := 0
+		f := big.NewInt(factor)
+		for new(big.Int).Mod(denom, f).Sign() == 0 {
+			denom.Quo(denom, f)
+			count++
+		}
+		if count > digits {
+			digits = count
+		}
+	}
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		return percent.RatString() + "%"
+	}
+	return percent.FloatString(digits) + "%"
+}
+
+// Percent returns new Money struct with value representing p of Self, e.g. the 5% tax of a price.
+// The product is computed exactly before the rounding mode and smallest denomination are applied.
+func (m *Money) Percent(p Percentage) (*Money, error) {
+	return m.MultiplyRat(p.Rat())
+}
+
+// BasisPoints returns new Money struct with value representing bps hundredths of a percent of Self, e.g. 250 for 2.5%.
+// The product is computed exactly before the rounding mode and smallest denomination are applied.
+func (m *Money) BasisPoints(bps int64) (*Money, error) {
+	return m.MultiplyRat(big.NewRat(bps, basisPointsPerUnit))
+}
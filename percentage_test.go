@@ -0,0 +1,173 @@
+package money
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPercentage(t *testing.T) {
+	testTable := []struct {
+		percent  string
+		expected string
+		rate     *big.Rat
+	}{
+		{
+			percent:  "5",
+			expected: "5%",
+			rate:     big.NewRat(1, 20),
+		},
+		{
+			percent:  "5.25%",
+			expected: "5.25%",
+			rate:     big.NewRat(21, 400),
+		},
+		{
+			percent:  " 0.125 % ",
+			expected: "0.125%",
+			rate:     big.NewRat(1, 800),
+		},
+		{
+			percent:  "-10",
+			expected: "-10%",
+			rate:     big.NewRat(-1, 10),
+		},
+	}
+	for _, item := range testTable {
+		p, err := NewPercentage(item.percent)
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, p.String())
+		assert.Zero(t, item.rate.Cmp(p.Rat()), item.percent)
+	}
+
+	for _, percent := range []string{"five", "100/3", "1e2", "0x10"} {
+		_, err := NewPercentage(percent)
+		assert.ErrorIs(t, err, ErrInvalidDecimal, percent)
+	}
+	assert.Panics(t, func() { MustPercentage("five") })
+
+	assert.Equal(t, "0%", Percentage{}.String())
+	assert.Equal(t, "2.5%", PercentageFromBasisPoints(250).String())
+	assert.Equal(t, "7.5%", PercentageFromRat(big.NewRat(15, 2)).String())
+	assert.Equal(t, "100/3%", PercentageFromRat(big.NewRat(100, 3)).String())
+}
+
+func TestPercent(t *testing.T) {
+	testTable := []struct {
+		cents        int64
+		currency     string
+		percent      string
+		roundingMode RoundingMode
+		expected     int64
+	}{
+		{
+			cents:    10000,
+			currency: "USD",
+			percent:  "5",
+			expected: 500,
+		},
+		{
+			cents:        115,
+			currency:     "USD",
+			percent:      "7",
+			roundingMode: RoundHalfUp,
+			expected:     8,
+		},
+		{
+			cents:        1005,
+			currency:     "USD",
+			percent:      "10",
+			roundingMode: RoundHalfUp,
+			expected:     101,
+		},
+		{
+			cents:        1005,
+			currency:     "USD",
+			percent:      "10",
+			roundingMode: RoundBankers,
+			expected:     100,
+		},
+		{
+			cents:        -1005,
+			currency:     "USD",
+			percent:      "10",
+			roundingMode: RoundHalfUp,
+			expected:     -101,
+		},
+		{
+			cents:    9999,
+			currency: "JPY",
+			percent:  "8",
+			expected: 800,
+		},
+	}
+	for _, item := range testTable {
+		m := New(item.cents, item.currency, WithRoundingMode(item.roundingMode))
+		p, err := m.Percent(MustPercentage(item.percent))
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, p.Cents, item.percent)
+		assert.Equal(t, item.currency, p.CurrencyIso)
+		assert.Equal(t, m.GetRoundingMode(), p.GetRoundingMode())
+	}
+
+	p, err := New(10000, "USD").Percent(PercentageFromRat(big.NewRat(100, 3)))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3333), p.Cents)
+
+	p, err = New(10000, "USD").Percent(Percentage{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), p.Cents)
+
+	_, err = New(math.MaxInt64, "USD").Percent(MustPercentage("200"))
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestBasisPoints(t *testing.T) {
+	testTable := []struct {
+		cents        int64
+		bps          int64
+		roundingMode RoundingMode
+		expected     int64
+	}{
+		{
+			cents:    100000,
+			bps:      250,
+			expected: 2500,
+		},
+		{
+			cents:        1234,
+			bps:          15,
+			roundingMode: RoundHalfUp,
+			expected:     2,
+		},
+		{
+			cents:        1234,
+			bps:          15,
+			roundingMode: RoundDown,
+			expected:     1,
+		},
+		{
+			cents:    2000,
+			bps:      25,
+			expected: 5,
+		},
+		{
+			cents:        -3000,
+			bps:          25,
+			roundingMode: RoundHalfUp,
+			expected:     -8,
+		},
+	}
+	for _, item := range testTable {
+		m := New(item.cents, "USD", WithRoundingMode(item.roundingMode))
+		b, err := m.BasisPoints(item.bps)
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, b.Cents)
+
+		p, err := m.Percent(PercentageFromBasisPoints(item.bps))
+		assert.NoError(t, err)
+		assert.Equal(t, b.Cents, p.Cents)
+	}
+}
@@ -0,0 +1,30 @@
+package money
+
+// NewStrict is New for codes from untrusted input like API requests.
+// The code is normalized with NormalizeCurrencyCode, and codes that are neither registered, known to go-money nor to
+// ISO 4217 return UnknownCurrencyError instead of a Money of a made-up currency.
+func NewStrict(cents int64, isoCode string, options ...MoneyOption) (*Money, error) {
+	code, err := strictCode(isoCode, options)
+	if err != nil {
+		return nil, err
+	}
+	return New(cents, code, options...), nil
+}
+
+// NewFromAmountStrict is NewFromAmount with the code checked like NewStrict.
+// Amounts that do not fit in int64 cents return ErrOverflow.
+func NewFromAmountStrict(dollars float64, isoCode string, options ...MoneyOption) (*Money, error) {
+	code, err := strictCode(isoCode, options)
+	if err != nil {
+		return nil, err
+	}
+	return newFromAmount(dollars, code, options...)
+}
+
+func strictCode(isoCode string, options []MoneyOption) (string, error) {
+	code := NormalizeCurrencyCode(isoCode)
+	if _, err := registryFromOptions(options).resolveStrict(code); err != nil {
+		return "", err
+	}
+	return code, nil
+}
@@ -0,0 +1,78 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStrict(t *testing.T) {
+	testTable := []struct {
+		isoCode  string
+		expected string
+		label    string
+	}{
+		{
+			isoCode:  "USD",
+			expected: "USD",
+			label:    "US$1.00",
+		},
+		{
+			isoCode:  " usd ",
+			expected: "USD",
+			label:    "US$1.00",
+		},
+		{
+			isoCode:  "twd",
+			expected: "TWD",
+			label:    "NT$100",
+		},
+		{
+			// Known to ISO 4217 but not to go-money
+			isoCode:  "VED",
+			expected: "VED",
+			label:    "1.00VED",
+		},
+	}
+	for _, item := range testTable {
+		m, err := NewStrict(100, item.isoCode)
+		assert.NoError(t, err)
+		assert.Equal(t, item.expected, m.CurrencyIso)
+		assert.Equal(t, item.label, m.Label)
+	}
+
+	m, err := NewFromAmountStrict(12.345, "hkd ", WithRoundingMode(RoundUp))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1235), m.Cents)
+	assert.Equal(t, "HKD", m.CurrencyIso)
+}
+
+func TestNewStrict_UnknownCurrency(t *testing.T) {
+	for _, isoCode := range []string{"UDS", "", "  ", "US D"} {
+		_, err := NewStrict(100, isoCode)
+		assert.ErrorIs(t, err, ErrUnknownCurrency, isoCode)
+		_, err = NewFromAmountStrict(1, isoCode)
+		assert.ErrorIs(t, err, ErrUnknownCurrency, isoCode)
+	}
+
+	// Money created with New for a made-up code does not make the code known
+	New(100, "UDT")
+	_, err := NewStrict(100, "udt")
+	var unknown *UnknownCurrencyError
+	assert.True(t, errors.As(err, &unknown))
+	assert.Equal(t, "UDT", unknown.Code)
+	assert.EqualError(t, err, `unknown currency: "UDT"`)
+}
+
+func TestNewStrict_WithRegistry(t *testing.T) {
+	r := NewRegistry()
+	assert.NoError(t, r.Register(NewCurrency("PTS", 0, WithGrapheme(" pts"))))
+
+	m, err := NewStrict(1500, "pts", WithRegistry(r))
+	assert.NoError(t, err)
+	assert.Equal(t, "1,500 pts", m.Label)
+
+	_, err = NewStrict(1500, "pts")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+}
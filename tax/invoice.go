@@ -0,0 +1,173 @@
+package tax
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/samber/lo"
+	money "github.com/shoplineapp/go-money"
+)
+
+type Strategy string
+
+const (
+	// RoundPerUnit rounds the tax of a single unit, the line tax is the unit tax times the quantity
+	RoundPerUnit Strategy = "PER_UNIT"
+	// RoundPerLine rounds the tax of each line
+	RoundPerLine Strategy = "PER_LINE"
+	// RoundPerInvoice rounds the tax once per tax rate on the invoice total
+	RoundPerInvoice Strategy = "PER_INVOICE"
+)
+
+var (
+	// Error
+	ErrInvalidStrategy = errors.New("invalid strategy: must be per unit, per line or per invoice")
+	ErrInvalidQuantity = errors.New("invalid quantity: quantity must not be negative")
+	ErrEmptyInvoice    = errors.New("invalid invoice: at least one line item is required")
+)
+
+// LineItem is a line of an invoice, UnitPrice is quoted before or after tax depending on the pricing of the calculator
+type LineItem struct {
+	UnitPrice *money.Money
+	Quantity  int64
+	Rate      money.Percentage
+}
+
+// Invoice holds the breakdowns of an invoice, the breakdowns of Lines sum up to Total.
+// Lines is nil for RoundPerInvoice as the tax is not rounded per line.
+type Invoice struct {
+	Lines []*Breakdown
+	Total *Breakdown
+}
+
+type Calculator struct {
+	pricing  Pricing
+	strategy Strategy
+}
+
+// NewCalculator creates Calculator computing invoices of pricing with strategy.
+// Every service computing the same invoice with the same pricing and strategy gets the same amounts.
+func NewCalculator(pricing Pricing, strategy Strategy) *Calculator {
+	return &Calculator{
+		pricing:  pricing,
+		strategy: strategy,
+	}
+}
+
+// Calculate returns the breakdowns of items, the tax is rounded with the rounding mode and smallest denomination of
+// the unit price of the first item. All items must have the same currency.
+func (c *Calculator) Calculate(items []LineItem) (*Invoice, error) {
+	if len(items) == 0 {
+		return nil, ErrEmptyInvoice
+	}
+	for _, item := range items {
+		if item.Quantity < 0 {
+			return nil, ErrInvalidQuantity
+		}
+	}
+
+	switch c.strategy {
+	case RoundPerUnit, RoundPerLine:
+		invoice := &Invoice{Lines: make([]*Breakdown, 0, len(items))}
+		for _, item := range items {
+			line, err := c.calculateLine(item)
+			if err != nil {
+				return nil, err
+			}
+			invoice.Lines = append(invoice.Lines, line)
+		}
+		total, err := sum(invoice.Lines)
+		if err != nil {
+			return nil, err
+		}
+		invoice.Total = total
+		return invoice, nil
+	case RoundPerInvoice:
+		total, err := c.calculateTotal(items)
+		if err != nil {
+			return nil, err
+		}
+		return &Invoice{Total: total}, nil
+	default:
+		return nil, ErrInvalidStrategy
+	}
+}
+
+func (c *Calculator) calculateLine(item LineItem) (*Breakdown, error) {
+	quantity := new(big.Rat).SetInt64(item.Quantity)
+	if c.strategy == RoundPerLine {
+		price, err := item.UnitPrice.MultiplyRat(quantity)
+		if err != nil {
+			return nil, err
+		}
+		return Calculate(price, item.Rate, c.pricing)
+	}
+
+	unit, err := Calculate(item.UnitPrice, item.Rate, c.pricing)
+	if err != nil {
+		return nil, err
+	}
+	net, err := unit.Net.MultiplyRat(quantity)
+	if err != nil {
+		return nil, err
+	}
+	tax, err := unit.Tax.MultiplyRat(quantity)
+	if err != nil {
+		return nil, err
+	}
+	gross, err := unit.Gross.MultiplyRat(quantity)
+	if err != nil {
+		return nil, err
+	}
+	return &Breakdown{Net: net, Tax: tax, Gross: gross}, nil
+}
+
+// calculateTotal sums the line prices of each tax rate before computing the tax of the rate
+func (c *Calculator) calculateTotal(items []LineItem) (*Breakdown, error) {
+	var rates []money.Percentage
+	prices := map[string]*money.Money{}
+	for _, item := range items {
+		price, err := item.UnitPrice.MultiplyRat(new(big.Rat).SetInt64(item.Quantity))
+		if err != nil {
+			return nil, err
+		}
+		key := item.Rate.Rat().RatString()
+		if total, ok := prices[key]; ok {
+			if price, err = total.Add(price); err != nil {
+				return nil, err
+			}
+		} else {
+			rates = append(rates, item.Rate)
+		}
+		prices[key] = price
+	}
+
+	breakdowns := make([]*Breakdown, 0, len(rates))
+	for _, rate := range rates {
+		breakdown, err := Calculate(prices[rate.Rat().RatString()], rate, c.pricing)
+		if err != nil {
+			return nil, err
+		}
+		breakdowns = append(breakdowns, breakdown)
+	}
+	return sum(breakdowns)
+}
+
+func sum(breakdowns []*Breakdown) (*Breakdown, error) {
+	amounts := func(amount func(b *Breakdown) *money.Money) []*money.Money {
+		return lo.Map(breakdowns[1:], func(b *Breakdown, _ int) *money.Money { return amount(b) })
+	}
+	net, err := breakdowns[0].Net.Add(amounts(func(b *Breakdown) *money.Money { return b.Net })...)
+	if err != nil {
+		return nil, err
+	}
+	tax, err := breakdowns[0].Tax.Add(amounts(func(b *Breakdown) *money.Money { return b.Tax })...)
+	if err != nil {
+		return nil, err
+	}
+	gross, err := breakdowns[0].Gross.Add(amounts(func(b *Breakdown) *money.Money { return b.Gross })...)
+	if err != nil {
+		return nil, err
+	}
+	return &Breakdown{Net: net, Tax: tax, Gross: gross}, nil
+}
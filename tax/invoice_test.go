@@ -0,0 +1,130 @@
+package tax
+
+import (
+	"testing"
+
+	gomoney "github.com/Rhymond/go-money"
+	money "github.com/shoplineapp/go-money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculator_Calculate(t *testing.T) {
+	usd := func(cents int64) *money.Money {
+		return money.New(cents, "USD", money.WithRoundingMode(money.RoundHalfUp))
+	}
+	items := []LineItem{
+		{UnitPrice: usd(15), Quantity: 3, Rate: money.MustPercentage("10")},
+		{UnitPrice: usd(25), Quantity: 1, Rate: money.MustPercentage("10")},
+		{UnitPrice: usd(10), Quantity: 1, Rate: money.MustPercentage("5")},
+	}
+	testTable := []struct {
+		strategy      Strategy
+		expectedLines []int64
+		expectedTax   int64
+	}{
+		{
+			strategy:      RoundPerUnit,
+			expectedLines: []int64{6, 3, 1},
+			expectedTax:   10,
+		},
+		{
+			strategy:      RoundPerLine,
+			expectedLines: []int64{5, 3, 1},
+			expectedTax:   9,
+		},
+		{
+			// 7 for 10% of 0.70 and 1 for 5% of 0.10
+			strategy:    RoundPerInvoice,
+			expectedTax: 8,
+		},
+	}
+	for _, item := range testTable {
+		invoice, err := NewCalculator(Exclusive, item.strategy).Calculate(items)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(80), invoice.Total.Net.Cents, item.strategy)
+		assert.Equal(t, item.expectedTax, invoice.Total.Tax.Cents, item.strategy)
+		assert.Equal(t, 80+item.expectedTax, invoice.Total.Gross.Cents, item.strategy)
+		if item.expectedLines == nil {
+			assert.Nil(t, invoice.Lines)
+			continue
+		}
+		assert.Len(t, invoice.Lines, len(items))
+		for i, line := range invoice.Lines {
+			assert.Equal(t, item.expectedLines[i], line.Tax.Cents, item.strategy)
+			assert.Equal(t, line.Gross.Cents, line.Net.Cents+line.Tax.Cents)
+		}
+	}
+}
+
+func TestCalculator_CalculateInclusive(t *testing.T) {
+	jpy := func(cents int64) *money.Money {
+		return money.New(cents, "JPY")
+	}
+	items := []LineItem{
+		{UnitPrice: jpy(198), Quantity: 3, Rate: money.MustPercentage("8")},
+		{UnitPrice: jpy(330), Quantity: 2, Rate: money.MustPercentage("10")},
+		{UnitPrice: jpy(55), Quantity: 7, Rate: money.MustPercentage("10")},
+	}
+	for _, strategy := range []Strategy{RoundPerUnit, RoundPerLine, RoundPerInvoice} {
+		invoice, err := NewCalculator(Inclusive, strategy).Calculate(items)
+		assert.NoError(t, err)
+		// Inclusive prices are what the customer pays whatever the strategy
+		assert.Equal(t, int64(1639), invoice.Total.Gross.Cents, strategy)
+		assert.Equal(t, invoice.Total.Gross.Cents, invoice.Total.Net.Cents+invoice.Total.Tax.Cents, strategy)
+	}
+
+	invoice, err := NewCalculator(Inclusive, RoundPerInvoice).Calculate(items)
+	assert.NoError(t, err)
+	// 44 for 8/108 of 594 and 95 for 10/110 of 1045
+	assert.Equal(t, int64(139), invoice.Total.Tax.Cents)
+}
+
+func TestCalculator_CalculateWithError(t *testing.T) {
+	rate := money.MustPercentage("10")
+	testTable := []struct {
+		calculator *Calculator
+		items      []LineItem
+		expected   error
+	}{
+		{
+			calculator: NewCalculator(Exclusive, RoundPerLine),
+			items:      nil,
+			expected:   ErrEmptyInvoice,
+		},
+		{
+			calculator: NewCalculator(Exclusive, RoundPerLine),
+			items:      []LineItem{{UnitPrice: money.New(100, "USD"), Quantity: -1, Rate: rate}},
+			expected:   ErrInvalidQuantity,
+		},
+		{
+			calculator: NewCalculator(Exclusive, Strategy("PER_ORDER")),
+			items:      []LineItem{{UnitPrice: money.New(100, "USD"), Quantity: 1, Rate: rate}},
+			expected:   ErrInvalidStrategy,
+		},
+		{
+			calculator: NewCalculator(Pricing("GROSS"), RoundPerUnit),
+			items:      []LineItem{{UnitPrice: money.New(100, "USD"), Quantity: 1, Rate: rate}},
+			expected:   ErrInvalidPricing,
+		},
+		{
+			calculator: NewCalculator(Exclusive, RoundPerInvoice),
+			items: []LineItem{
+				{UnitPrice: money.New(100, "USD"), Quantity: 1, Rate: rate},
+				{UnitPrice: money.New(100, "TWD"), Quantity: 1, Rate: rate},
+			},
+			expected: gomoney.ErrCurrencyMismatch,
+		},
+		{
+			calculator: NewCalculator(Exclusive, RoundPerLine),
+			items: []LineItem{
+				{UnitPrice: money.New(100, "USD"), Quantity: 1, Rate: rate},
+				{UnitPrice: money.New(100, "TWD"), Quantity: 1, Rate: rate},
+			},
+			expected: gomoney.ErrCurrencyMismatch,
+		},
+	}
+	for _, item := range testTable {
+		_, err := item.calculator.Calculate(item.items)
+		assert.ErrorIs(t, err, item.expected)
+	}
+}
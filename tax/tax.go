@@ -0,0 +1,79 @@
+// Package tax computes the net, tax and gross amounts of tax-inclusive and tax-exclusive prices.
+package tax
+
+import (
+	"errors"
+	"math/big"
+
+	money "github.com/shoplineapp/go-money"
+)
+
+type Pricing string
+
+const (
+	// Exclusive prices are quoted before tax, e.g. in the US
+	Exclusive Pricing = "EXCLUSIVE"
+	// Inclusive prices are quoted with tax included, e.g. in TW, JP, SG and AU
+	Inclusive Pricing = "INCLUSIVE"
+)
+
+var (
+	// Error
+	ErrInvalidRate    = errors.New("invalid tax rate: rate must not be negative")
+	ErrInvalidPricing = errors.New("invalid pricing: must be inclusive or exclusive")
+)
+
+// Breakdown holds the amounts of a taxed price, Net plus Tax always equals Gross
+type Breakdown struct {
+	Net   *money.Money
+	Tax   *money.Money
+	Gross *money.Money
+}
+
+// Calculate returns the breakdown of price taxed at rate.
+//
+// For exclusive pricing price is the net amount and the tax is rate of it.
+// For inclusive pricing price is the gross amount and the tax is rate / (1 + rate) of it, e.g. 1/11 for 10% GST.
+// Only the tax is rounded, with the rounding mode and smallest denomination of price, the remaining amount is derived
+// by subtraction so the breakdown always reconciles.
+func Calculate(price *money.Money, rate money.Percentage, pricing Pricing) (*Breakdown, error) {
+	r := rate.Rat()
+	if r.Sign() < 0 {
+		return nil, ErrInvalidRate
+	}
+
+	switch pricing {
+	case Exclusive:
+		tax, err := price.MultiplyRat(r)
+		if err != nil {
+			return nil, err
+		}
+		gross, err := price.Add(tax)
+		if err != nil {
+			return nil, err
+		}
+		return &Breakdown{Net: price, Tax: tax, Gross: gross}, nil
+	case Inclusive:
+		tax, err := price.MultiplyRat(r.Quo(r, new(big.Rat).Add(big.NewRat(1, 1), r)))
+		if err != nil {
+			return nil, err
+		}
+		net, err := price.Subtract(tax)
+		if err != nil {
+			return nil, err
+		}
+		return &Breakdown{Net: net, Tax: tax, Gross: price}, nil
+	default:
+		return nil, ErrInvalidPricing
+	}
+}
+
+// CalculateExclusive returns the breakdown of a price quoted before tax, see Calculate
+func CalculateExclusive(price *money.Money, rate money.Percentage) (*Breakdown, error) {
+	return Calculate(price, rate, Exclusive)
+}
+
+// CalculateInclusive returns the breakdown of a price quoted with tax included, see Calculate
+func CalculateInclusive(price *money.Money, rate money.Percentage) (*Breakdown, error) {
+	return Calculate(price, rate, Inclusive)
+}
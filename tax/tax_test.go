@@ -0,0 +1,141 @@
+package tax
+
+import (
+	"testing"
+
+	money "github.com/shoplineapp/go-money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculate(t *testing.T) {
+	testTable := []struct {
+		cents         int64
+		currency      string
+		rate          string
+		pricing       Pricing
+		roundingMode  money.RoundingMode
+		expectedNet   int64
+		expectedTax   int64
+		expectedGross int64
+	}{
+		{
+			cents:         10000,
+			currency:      "USD",
+			rate:          "8.875",
+			pricing:       Exclusive,
+			expectedNet:   10000,
+			expectedTax:   888,
+			expectedGross: 10888,
+		},
+		{
+			cents:         10000,
+			currency:      "USD",
+			rate:          "8.875",
+			pricing:       Exclusive,
+			roundingMode:  money.RoundDown,
+			expectedNet:   10000,
+			expectedTax:   887,
+			expectedGross: 10887,
+		},
+		{
+			cents:         105,
+			currency:      "TWD",
+			rate:          "5",
+			pricing:       Inclusive,
+			expectedNet:   100,
+			expectedTax:   5,
+			expectedGross: 105,
+		},
+		{
+			cents:         1000,
+			currency:      "JPY",
+			rate:          "8",
+			pricing:       Inclusive,
+			expectedNet:   926,
+			expectedTax:   74,
+			expectedGross: 1000,
+		},
+		{
+			cents:         1999,
+			currency:      "SGD",
+			rate:          "9",
+			pricing:       Inclusive,
+			expectedNet:   1834,
+			expectedTax:   165,
+			expectedGross: 1999,
+		},
+		{
+			cents:         1000,
+			currency:      "AUD",
+			rate:          "10",
+			pricing:       Inclusive,
+			expectedNet:   909,
+			expectedTax:   91,
+			expectedGross: 1000,
+		},
+		{
+			cents:         -1000,
+			currency:      "AUD",
+			rate:          "10",
+			pricing:       Inclusive,
+			expectedNet:   -909,
+			expectedTax:   -91,
+			expectedGross: -1000,
+		},
+		{
+			cents:         1000,
+			currency:      "HKD",
+			rate:          "0",
+			pricing:       Inclusive,
+			expectedNet:   1000,
+			expectedTax:   0,
+			expectedGross: 1000,
+		},
+	}
+	for _, item := range testTable {
+		price := money.New(item.cents, item.currency, money.WithRoundingMode(item.roundingMode))
+		b, err := Calculate(price, money.MustPercentage(item.rate), item.pricing)
+		assert.NoError(t, err)
+		assert.Equal(t, item.expectedNet, b.Net.Cents, item.currency)
+		assert.Equal(t, item.expectedTax, b.Tax.Cents, item.currency)
+		assert.Equal(t, item.expectedGross, b.Gross.Cents, item.currency)
+		assert.Equal(t, item.currency, b.Tax.CurrencyIso)
+		assert.Equal(t, price.GetRoundingMode(), b.Tax.GetRoundingMode())
+	}
+}
+
+func TestCalculate_Reconciles(t *testing.T) {
+	for _, rate := range []string{"5", "7", "8", "9", "10", "8.875", "17.5"} {
+		for cents := int64(0); cents < 2000; cents++ {
+			price := money.New(cents, "USD")
+			for _, pricing := range []Pricing{Exclusive, Inclusive} {
+				b, err := Calculate(price, money.MustPercentage(rate), pricing)
+				assert.NoError(t, err)
+				assert.Equal(t, b.Gross.Cents, b.Net.Cents+b.Tax.Cents)
+			}
+		}
+	}
+}
+
+func TestCalculate_SmallestDenomination(t *testing.T) {
+	// Tax is rounded to the smallest denomination of the price
+	price := money.New(10000, "TWD", money.WithSmallestDenomination(10))
+	b, err := CalculateInclusive(price, money.MustPercentage("5"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(480), b.Tax.Cents)
+	assert.Equal(t, int64(9520), b.Net.Cents)
+
+	b, err = CalculateExclusive(money.New(1234, "TWD", money.WithSmallestDenomination(10)), money.MustPercentage("5"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60), b.Tax.Cents)
+	assert.Equal(t, int64(1294), b.Gross.Cents)
+}
+
+func TestCalculate_WithError(t *testing.T) {
+	price := money.New(1000, "USD")
+	_, err := Calculate(price, money.MustPercentage("-5"), Exclusive)
+	assert.ErrorIs(t, err, ErrInvalidRate)
+
+	_, err = Calculate(price, money.MustPercentage("5"), Pricing("GROSS"))
+	assert.ErrorIs(t, err, ErrInvalidPricing)
+}